@@ -372,6 +372,11 @@ func convertType(v reflect.Value, tag reflect.StructTag) (str string, err error)
 			return "", fmt.Errorf("unable to encode JSONValue, %v", err)
 		}
 	default:
+		if v.Kind() == reflect.String {
+			// Named string types, such as enums
+			str = v.String()
+			break
+		}
 		logger.Debug("convertType: unsupported value for param %v (%s)", v.Interface(), v.Type())
 		err := fmt.Errorf("unsupported value for param %v (%s)", v.Interface(), v.Type())
 		return "", err
@@ -81,6 +81,8 @@ func TestBuild_URIParameters(t *testing.T) {
 	}
 }
 
+type testStatus string
+
 func TestBuild_QueryParameters(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -118,6 +120,13 @@ func TestBuild_QueryParameters(t *testing.T) {
 			},
 			expected: "tags=gpu&tags=compute",
 		},
+		{
+			name: "named string parameter",
+			params: &struct {
+				Status testStatus `location:"querystring" locationName:"status"`
+			}{Status: "running"},
+			expected: "status=running",
+		},
 	}
 
 	for _, tt := range tests {
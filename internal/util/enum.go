@@ -0,0 +1,22 @@
+package util
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// DecodeEnum leniently decodes a JSON enum value. Strings are returned as is,
+// null as an empty string and any other value as its JSON text, so that values
+// unknown to the SDK never fail decoding the enclosing response.
+func DecodeEnum(data []byte) string {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return ""
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return s
+	}
+	return string(data)
+}
@@ -0,0 +1,24 @@
+package util
+
+import "testing"
+
+func TestDecodeEnum(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`"running"`, "running"},
+		{`"some_new_status"`, "some_new_status"},
+		{` "offline" `, "offline"},
+		{`null`, ""},
+		{`""`, ""},
+		{`3`, "3"},
+		{`true`, "true"},
+	}
+
+	for _, tt := range tests {
+		if got := DecodeEnum([]byte(tt.data)); got != tt.want {
+			t.Errorf("DecodeEnum(%s) = %q, want %q", tt.data, got, tt.want)
+		}
+	}
+}
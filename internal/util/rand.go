@@ -1,9 +1,22 @@
 package util
 
 import (
+	cryptorand "crypto/rand"
+	"fmt"
 	mathrand "math/rand"
 	"time"
 )
 
 // SeededRand is a global random instance that is seeded (for non-cryptographic use)
 var SeededRand = mathrand.New(mathrand.NewSource(time.Now().UnixNano()))
+
+// NewUUID returns a random (version 4) UUID generated with crypto/rand
+func NewUUID() (string, error) {
+	var b [16]byte
+	if _, err := cryptorand.Read(b[:]); err != nil {
+		return "", err
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
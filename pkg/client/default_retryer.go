@@ -2,6 +2,9 @@ package client
 
 import (
 	"math"
+	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/datacrunch-io/datacrunch-sdk-go/internal/util"
@@ -90,6 +93,10 @@ func (d *DefaultRetryer) setRetryerDefaults() {
 }
 
 // RetryRules returns the delay duration before retrying this request again
+//
+// Throttled requests whose response carries a Retry-After header are delayed
+// by the server-provided duration, capped by MaxThrottleDelay. Otherwise the
+// delay is computed with exponential backoff and jitter.
 func (d DefaultRetryer) RetryRules(r *request.Request) time.Duration {
 
 	// if number of max retries is zero, no retries will be performed.
@@ -103,21 +110,24 @@ func (d DefaultRetryer) RetryRules(r *request.Request) time.Duration {
 	// minDelay is the minimum retryer delay
 	minDelay := d.MinRetryDelay
 
-	var initialDelay time.Duration
+	// maxDelay the maximum retryer delay
+	maxDelay := d.MaxRetryDelay
 
-	// isThrottle := r.IsErrorThrottle()
-	// if isThrottle {
-	// 	if delay, ok := getRetryAfterDelay(r); ok {
-	// 		initialDelay = delay
-	// 	}
-	// 	minDelay = d.MinThrottleDelay
-	// }
+	r.RetryAfter = 0
+	if r.IsErrorThrottle() {
+		if delay, ok := getRetryAfterDelay(r); ok {
+			if delay > d.MaxThrottleDelay {
+				delay = d.MaxThrottleDelay
+			}
+			r.RetryAfter = delay
+			return delay
+		}
+		minDelay = d.MinThrottleDelay
+		maxDelay = d.MaxThrottleDelay
+	}
 
 	retryCount := r.RetryCount
 
-	// maxDelay the maximum retryer delay
-	maxDelay := d.MaxRetryDelay
-
 	var delay time.Duration
 
 	// Logic to cap the retry count based on the minDelay provided
@@ -130,7 +140,37 @@ func (d DefaultRetryer) RetryRules(r *request.Request) time.Duration {
 	} else {
 		delay = getJitterDelay(maxDelay / 2)
 	}
-	return delay + initialDelay
+	return delay
+}
+
+// getRetryAfterDelay returns the delay requested by the Retry-After header of
+// the response. Both the delay-seconds and HTTP-date forms are supported.
+func getRetryAfterDelay(r *request.Request) (time.Duration, bool) {
+	if r.HTTPResponse == nil {
+		return 0, false
+	}
+
+	value := strings.TrimSpace(r.HTTPResponse.Header.Get("Retry-After"))
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
 }
 
 // getJitterDelay returns a jittered delay for retry
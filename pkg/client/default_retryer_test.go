@@ -0,0 +1,65 @@
+package client
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+func newThrottledRequest(statusCode int, retryAfter string) *request.Request {
+	header := http.Header{}
+	if retryAfter != "" {
+		header.Set("Retry-After", retryAfter)
+	}
+	return &request.Request{
+		HTTPResponse: &http.Response{StatusCode: statusCode, Header: header},
+	}
+}
+
+func TestDefaultRetryer_RetryAfterSeconds(t *testing.T) {
+	r := newThrottledRequest(http.StatusTooManyRequests, "7")
+
+	delay := NewDefaultRetryerWithDefaults().RetryRules(r)
+	if delay != 7*time.Second {
+		t.Errorf("expected 7s delay, got %v", delay)
+	}
+	if r.RetryAfter != 7*time.Second {
+		t.Errorf("expected RetryAfter to be recorded, got %v", r.RetryAfter)
+	}
+}
+
+func TestDefaultRetryer_RetryAfterHTTPDate(t *testing.T) {
+	date := time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat)
+	r := newThrottledRequest(http.StatusServiceUnavailable, date)
+
+	delay := NewDefaultRetryerWithDefaults().RetryRules(r)
+	if delay <= 25*time.Second || delay > 30*time.Second {
+		t.Errorf("expected ~30s delay, got %v", delay)
+	}
+}
+
+func TestDefaultRetryer_RetryAfterCappedByMaxThrottleDelay(t *testing.T) {
+	r := newThrottledRequest(http.StatusTooManyRequests, "3600")
+
+	retryer := NewDefaultRetryerWithDefaults()
+	retryer.MaxThrottleDelay = 10 * time.Second
+
+	if delay := retryer.RetryRules(r); delay != 10*time.Second {
+		t.Errorf("expected delay capped to 10s, got %v", delay)
+	}
+}
+
+func TestDefaultRetryer_InvalidRetryAfterFallsBackToBackoff(t *testing.T) {
+	r := newThrottledRequest(http.StatusTooManyRequests, "soon")
+
+	retryer := NewDefaultRetryerWithDefaults()
+	delay := retryer.RetryRules(r)
+	if delay < retryer.MinThrottleDelay || delay > 2*retryer.MinThrottleDelay {
+		t.Errorf("expected throttle backoff delay, got %v", delay)
+	}
+	if r.RetryAfter != 0 {
+		t.Errorf("expected RetryAfter to be unset, got %v", r.RetryAfter)
+	}
+}
@@ -2,9 +2,11 @@ package config
 
 import (
 	"log/slog"
+	"net/http"
 	"time"
 
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/credentials"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/ratelimit"
 )
 
 // Config holds configuration for the DataCrunch SDK
@@ -13,6 +15,11 @@ type Config struct {
 	BaseURL *string
 	Timeout *time.Duration
 
+	// HTTPClient is used for every request attempt and for OAuth2 token
+	// requests. If nil, http.DefaultClient is used. Timeout is applied per
+	// attempt on top of any timeout configured on the client itself.
+	HTTPClient *http.Client
+
 	// Credential configuration
 	Credentials *credentials.Credentials
 
@@ -20,6 +27,12 @@ type Config struct {
 	MaxRetries *int
 	Retryer    interface{}
 
+	// RateLimiter, if set, delays every request attempt to stay within its
+	// rate. RetryQuota, if set, limits the number of retries made once
+	// requests start failing. Both are shared by every client using them.
+	RateLimiter *ratelimit.Limiter
+	RetryQuota  *ratelimit.RetryQuota
+
 	// Logging configuration default to false
 	Debug  bool
 	Logger *slog.Logger
@@ -33,9 +46,12 @@ func (c *Config) Copy(cfgs ...*Config) *Config {
 	newConfig := &Config{
 		BaseURL:     c.BaseURL,
 		Timeout:     c.Timeout,
+		HTTPClient:  c.HTTPClient,
 		Credentials: c.Credentials,
 		MaxRetries:  c.MaxRetries,
 		Retryer:     c.Retryer,
+		RateLimiter: c.RateLimiter,
+		RetryQuota:  c.RetryQuota,
 		Debug:       c.Debug,
 		Logger:      c.Logger,
 	}
@@ -47,6 +63,9 @@ func (c *Config) Copy(cfgs ...*Config) *Config {
 		if cfg.Timeout != nil {
 			newConfig.Timeout = cfg.Timeout
 		}
+		if cfg.HTTPClient != nil {
+			newConfig.HTTPClient = cfg.HTTPClient
+		}
 		if cfg.Credentials != nil {
 			newConfig.Credentials = cfg.Credentials
 		}
@@ -56,6 +75,12 @@ func (c *Config) Copy(cfgs ...*Config) *Config {
 		if cfg.Retryer != nil {
 			newConfig.Retryer = cfg.Retryer
 		}
+		if cfg.RateLimiter != nil {
+			newConfig.RateLimiter = cfg.RateLimiter
+		}
+		if cfg.RetryQuota != nil {
+			newConfig.RetryQuota = cfg.RetryQuota
+		}
 		if cfg.Logger != nil {
 			newConfig.Logger = cfg.Logger
 		}
@@ -99,6 +124,13 @@ func WithTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithHTTPClient sets the HTTP client used to send requests
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Config) {
+		c.HTTPClient = client
+	}
+}
+
 // WithCredentialsProvider sets custom credentials provider
 func WithCredentialsProvider(creds *credentials.Credentials) Option {
 	return func(c *Config) {
@@ -126,6 +158,21 @@ func WithNoRetries() Option {
 	return WithRetryConfig(0, 0, 0)
 }
 
+// WithRateLimit limits requests to requestsPerSecond with bursts of up to
+// burst requests
+func WithRateLimit(requestsPerSecond float64, burst int) Option {
+	return func(c *Config) {
+		c.RateLimiter = ratelimit.NewLimiter(requestsPerSecond, burst)
+	}
+}
+
+// WithRetryQuota limits retries with a retry quota of the given capacity
+func WithRetryQuota(capacity int) Option {
+	return func(c *Config) {
+		c.RetryQuota = ratelimit.NewRetryQuota(capacity)
+	}
+}
+
 // WithDebug enables or disables debug logging
 func WithDebug(debug bool) Option {
 	return func(c *Config) {
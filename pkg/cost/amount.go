@@ -0,0 +1,221 @@
+// Package cost estimates what DataCrunch resources cost. Prices are handled
+// as exact decimal amounts tagged with their currency.
+package cost
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+)
+
+// ErrCodeCurrencyMismatch is returned when adding amounts in different
+// currencies.
+const ErrCodeCurrencyMismatch = "CurrencyMismatch"
+
+// HoursPerMonth is the number of hours in a billing month, used to convert
+// monthly prices to hourly ones and back.
+const HoursPerMonth = 730
+
+// nanosPerUnit is the number of nanos in one unit of currency.
+const nanosPerUnit = 1_000_000_000
+
+// fractionDigits is the number of decimal places an Amount keeps.
+const fractionDigits = 9
+
+// Amount is an exact amount of money, to a billionth of a currency unit. The
+// zero value is zero in no currency, which can be added to amounts in any
+// currency.
+type Amount struct {
+	nanos    int64
+	currency string
+}
+
+// NewAmount returns the amount closest to v in the given currency.
+func NewAmount(v float64, currency string) Amount {
+	return Amount{
+		nanos:    int64(math.Round(v * nanosPerUnit)),
+		currency: normalizeCurrency(currency),
+	}
+}
+
+// ParseAmount parses a decimal number such as "1.29" into an amount in the
+// given currency. Digits beyond the ninth decimal place are rounded.
+func ParseAmount(s, currency string) (Amount, error) {
+	raw := s
+	s = strings.TrimSpace(s)
+
+	negative := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		negative = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if whole == "" && fraction == "" || !isDigits(whole) || !isDigits(fraction) {
+		return Amount{}, fmt.Errorf("invalid amount %q", raw)
+	}
+
+	var units int64
+	if whole != "" {
+		var err error
+		units, err = strconv.ParseInt(whole, 10, 64)
+		if err != nil || units > math.MaxInt64/nanosPerUnit-1 {
+			return Amount{}, fmt.Errorf("amount %q out of range", raw)
+		}
+	}
+
+	roundUp := len(fraction) > fractionDigits && fraction[fractionDigits] >= '5'
+	if len(fraction) > fractionDigits {
+		fraction = fraction[:fractionDigits]
+	}
+	fraction += strings.Repeat("0", fractionDigits-len(fraction))
+	nanos, _ := strconv.ParseInt(fraction, 10, 64)
+
+	nanos += units * nanosPerUnit
+	if roundUp {
+		nanos++
+	}
+	if negative {
+		nanos = -nanos
+	}
+	return Amount{nanos: nanos, currency: normalizeCurrency(currency)}, nil
+}
+
+// Currency returns the upper case ISO code of the currency, empty for the
+// zero value.
+func (a Amount) Currency() string {
+	return a.currency
+}
+
+// IsZero returns true if the amount is zero, whatever its currency.
+func (a Amount) IsZero() bool {
+	return a.nanos == 0
+}
+
+// Float64 returns the amount as a float, for display or comparisons that do
+// not need to be exact.
+func (a Amount) Float64() float64 {
+	return float64(a.nanos) / nanosPerUnit
+}
+
+// Add returns the sum of the amounts. An error with the
+// ErrCodeCurrencyMismatch code is returned if they are in different
+// currencies.
+func (a Amount) Add(b Amount) (Amount, error) {
+	switch {
+	case a.currency == "":
+		a.currency = b.currency
+	case b.currency != "" && b.currency != a.currency:
+		return Amount{}, dcerr.New(ErrCodeCurrencyMismatch,
+			fmt.Sprintf("cannot add %s to %s", b.currency, a.currency), nil)
+	}
+	a.nanos += b.nanos
+	return a, nil
+}
+
+// Mul returns the amount multiplied by n.
+func (a Amount) Mul(n int64) Amount {
+	a.nanos *= n
+	return a
+}
+
+// MulFloat returns the amount multiplied by f, rounded to the nearest nano.
+func (a Amount) MulFloat(f float64) Amount {
+	a.nanos = int64(math.Round(float64(a.nanos) * f))
+	return a
+}
+
+// Div returns the amount divided by n, rounded half away from zero.
+func (a Amount) Div(n int64) Amount {
+	a.nanos = divRound(a.nanos, n)
+	return a
+}
+
+// Discount returns the amount reduced by the given percentage.
+func (a Amount) Discount(percentage float64) Amount {
+	return a.MulFloat(1 - percentage/100)
+}
+
+// Round returns the amount rounded half away from zero to the given number
+// of decimal places.
+func (a Amount) Round(places int) Amount {
+	if places < 0 || places >= fractionDigits {
+		return a
+	}
+	unit := int64(math.Pow10(fractionDigits - places))
+	a.nanos = divRound(a.nanos, unit) * unit
+	return a
+}
+
+// Decimal returns the amount as a decimal number with at least two decimal
+// places, such as "1.50" or "0.000273973".
+func (a Amount) Decimal() string {
+	nanos := a.nanos
+	sign := ""
+	if nanos < 0 {
+		sign = "-"
+		nanos = -nanos
+	}
+	fraction := fmt.Sprintf("%09d", nanos%nanosPerUnit)
+	fraction = strings.TrimRight(fraction, "0")
+	if len(fraction) < 2 {
+		fraction += strings.Repeat("0", 2-len(fraction))
+	}
+	return fmt.Sprintf("%s%d.%s", sign, nanos/nanosPerUnit, fraction)
+}
+
+// String returns the decimal amount followed by its currency.
+func (a Amount) String() string {
+	if a.currency == "" {
+		return a.Decimal()
+	}
+	return a.Decimal() + " " + a.currency
+}
+
+// Sum adds up the amounts. An error with the ErrCodeCurrencyMismatch code is
+// returned if they are not all in the same currency.
+func Sum(amounts ...Amount) (Amount, error) {
+	var total Amount
+	for _, a := range amounts {
+		var err error
+		if total, err = total.Add(a); err != nil {
+			return Amount{}, err
+		}
+	}
+	return total, nil
+}
+
+func normalizeCurrency(currency string) string {
+	return strings.ToUpper(strings.TrimSpace(currency))
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// divRound divides n by d, rounding half away from zero.
+func divRound(n, d int64) int64 {
+	q, r := n/d, n%d
+	if r < 0 {
+		r = -r
+	}
+	if 2*r >= d {
+		if n < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q
+}
@@ -0,0 +1,75 @@
+package cost
+
+import (
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "1.29", want: "1.29"},
+		{in: "0.1", want: "0.10"},
+		{in: "42", want: "42.00"},
+		{in: ".5", want: "0.50"},
+		{in: "-2.75", want: "-2.75"},
+		{in: " 0.000273972 ", want: "0.000273972"},
+		{in: "0.0000000015", want: "0.000000002"},
+		{in: "", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "1e3", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseAmount(tt.in, "usd")
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseAmount(%q): expected error, got %v", tt.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseAmount(%q): unexpected error: %v", tt.in, err)
+			continue
+		}
+		if got.Decimal() != tt.want || got.Currency() != "USD" {
+			t.Errorf("ParseAmount(%q) = %s, want %s USD", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestAmount_Arithmetic(t *testing.T) {
+	price, _ := ParseAmount("0.1", "USD")
+
+	// Exact where float64 is not: 0.1 * 3 == 0.3
+	if got := price.Mul(3).Decimal(); got != "0.30" {
+		t.Errorf("0.1 * 3 = %s, want 0.30", got)
+	}
+	if got := NewAmount(1, "USD").Div(3).Decimal(); got != "0.333333333" {
+		t.Errorf("1 / 3 = %s, want 0.333333333", got)
+	}
+	if got := NewAmount(2, "USD").Div(3).Decimal(); got != "0.666666667" {
+		t.Errorf("2 / 3 = %s, want 0.666666667", got)
+	}
+	if got := NewAmount(100, "USD").Discount(15).Decimal(); got != "85.00" {
+		t.Errorf("100 - 15%% = %s, want 85.00", got)
+	}
+	if got := NewAmount(1.005, "USD").Round(2).Decimal(); got != "1.01" {
+		t.Errorf("round(1.005, 2) = %s, want 1.01", got)
+	}
+
+	total, err := Sum(Amount{}, price, NewAmount(0.2, "usd"))
+	if err != nil || total.String() != "0.30 USD" {
+		t.Errorf("Sum = %v, %v, want 0.30 USD", total, err)
+	}
+
+	_, err = price.Add(NewAmount(1, "EUR"))
+	if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != ErrCodeCurrencyMismatch {
+		t.Errorf("expected %s error, got %v", ErrCodeCurrencyMismatch, err)
+	}
+}
@@ -0,0 +1,63 @@
+package cost
+
+import (
+	"sync"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/client"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instance"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instancetypes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumetypes"
+)
+
+// DefaultCacheTTL is how long an Estimator reuses prices listed by the API.
+const DefaultCacheTTL = time.Hour
+
+// Estimator prices resources using the prices listed by the API. Prices are
+// fetched on first use and cached for CacheTTL, so an Estimator should be
+// created once per session and shared. It is safe for concurrent use.
+type Estimator struct {
+	VolumeTypes   VolumeTypesAPI
+	InstanceTypes InstanceTypesAPI
+
+	// Instances and Volumes list the resources priced by ProjectSpend
+	Instances InstancesAPI
+	Volumes   VolumesAPI
+
+	// CacheTTL is how long listed prices are reused, DefaultCacheTTL if zero
+	CacheTTL time.Duration
+
+	mu                sync.Mutex
+	volumeTypes       map[volumes.VolumeType]*volumetypes.VolumeTypeResponse
+	volumeTypesTime   time.Time
+	instanceTypes     map[string]*InstanceTypePrices
+	instanceTypesTime time.Time
+}
+
+// New returns an Estimator using service clients created from the config
+// provider, typically a session.
+func New(p client.ConfigProvider) *Estimator {
+	return &Estimator{
+		VolumeTypes:   volumetypes.New(p),
+		InstanceTypes: instancetypes.New(p),
+		Instances:     instance.New(p),
+		Volumes:       volumes.New(p),
+	}
+}
+
+// Invalidate drops the cached prices, which are listed again on next use.
+func (e *Estimator) Invalidate() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.volumeTypes = nil
+	e.instanceTypes = nil
+}
+
+func (e *Estimator) cacheTTL() time.Duration {
+	if e.CacheTTL > 0 {
+		return e.CacheTTL
+	}
+	return DefaultCacheTTL
+}
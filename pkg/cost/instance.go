@@ -0,0 +1,310 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instance"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instancetypes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumes"
+)
+
+// InstanceTypesAPI lists instance types and their prices. It is satisfied by
+// the instancetypes client.
+type InstanceTypesAPI interface {
+	ListInstanceTypesWithContext(ctx context.Context, opts ...request.Option) ([]*instancetypes.InstanceTypeResponse, error)
+}
+
+// InstancesAPI lists instances. It is satisfied by the instance client.
+type InstancesAPI interface {
+	ListInstancesWithContext(ctx context.Context, input *instance.ListInstancesInput, opts ...request.Option) ([]*instance.ListInstancesResponse, error)
+}
+
+// VolumesAPI lists volumes. It is satisfied by the volumes client.
+type VolumesAPI interface {
+	ListVolumesWithContext(ctx context.Context, status *volumes.ListVolumesStatus, opts ...request.Option) ([]*volumes.VolumeResponse, error)
+}
+
+// InstanceTypePrices are the hourly prices of an instance type.
+type InstanceTypePrices struct {
+	InstanceType    string
+	PricePerHour    Amount
+	SpotPrice       Amount
+	DynamicPrice    Amount
+	MaxDynamicPrice Amount
+}
+
+// ParseInstanceTypePrices parses the prices of an instance type, which the
+// API returns as strings. Missing prices are zero.
+func ParseInstanceTypePrices(t *instancetypes.InstanceTypeResponse) (*InstanceTypePrices, error) {
+	if t == nil {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter, "an instance type is required", nil)
+	}
+
+	prices := &InstanceTypePrices{InstanceType: t.InstanceType}
+	for _, p := range []struct {
+		name   string
+		raw    string
+		amount *Amount
+	}{
+		{"price_per_hour", t.PricePerHour, &prices.PricePerHour},
+		{"spot_price", t.SpotPrice, &prices.SpotPrice},
+		{"dynamic_price", t.DynamicPrice, &prices.DynamicPrice},
+		{"max_dynamic_price", t.MaxDynamicPrice, &prices.MaxDynamicPrice},
+	} {
+		if p.raw == "" {
+			*p.amount = NewAmount(0, t.Currency)
+			continue
+		}
+		amount, err := ParseAmount(p.raw, t.Currency)
+		if err != nil {
+			return nil, dcerr.New(request.ErrCodeSerialization,
+				fmt.Sprintf("invalid %s of instance type %s", p.name, t.InstanceType), err)
+		}
+		*p.amount = amount
+	}
+	return prices, nil
+}
+
+// InstanceCost is the estimated cost of an instance to be launched.
+type InstanceCost struct {
+	InstanceType string
+	LocationCode string
+	Spot         bool
+	Pricing      instance.PricingType
+
+	// Hourly is the expected hourly price of the instance alone
+	Hourly Amount
+
+	// MaxHourly is the highest hourly price of the instance alone, which is
+	// above Hourly for dynamically priced instances
+	MaxHourly Amount
+
+	// Volumes are the costs of the OS volume and the new volumes
+	Volumes []*VolumeCost
+
+	// TotalHourly is the hourly price of the instance and its new volumes
+	TotalHourly Amount
+}
+
+// EstimateInstance returns the cost of the instance described by a create
+// input, before it is launched. The OS volume is priced as an NVMe volume,
+// as are new volumes without a type. Existing volumes are already paid for
+// and not included.
+func (e *Estimator) EstimateInstance(ctx context.Context, input *instance.CreateInstanceInput) (*InstanceCost, error) {
+	if input == nil || input.InstanceType == "" {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter, "an instance type is required", nil)
+	}
+
+	prices, ok, err := e.instanceTypePrices(ctx, input.InstanceType)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter,
+			fmt.Sprintf("unknown instance type %q", input.InstanceType), nil)
+	}
+
+	c := &InstanceCost{
+		InstanceType: input.InstanceType,
+		LocationCode: input.LocationCode,
+		Spot:         input.IsSpot,
+		Pricing:      input.Pricing,
+		Hourly:       prices.PricePerHour,
+	}
+	switch {
+	case input.IsSpot:
+		c.Hourly = prices.SpotPrice
+	case input.Pricing == instance.PricingDynamicPrice:
+		c.Hourly = prices.DynamicPrice
+	}
+	c.MaxHourly = c.Hourly
+	if !input.IsSpot && input.Pricing == instance.PricingDynamicPrice && !prices.MaxDynamicPrice.IsZero() {
+		c.MaxHourly = prices.MaxDynamicPrice
+	}
+
+	planned := make([]*volumes.CreateVolumeInput, 0, len(input.Volumes)+1)
+	if input.OSVolume != nil {
+		planned = append(planned, &volumes.CreateVolumeInput{
+			Type: volumes.VolumeTypeNVMe,
+			Size: input.OSVolume.Size,
+			Name: input.OSVolume.Name,
+		})
+	}
+	for _, v := range input.Volumes {
+		volumeType := volumes.VolumeType(v.Type)
+		if volumeType == "" {
+			volumeType = volumes.VolumeTypeNVMe
+		}
+		planned = append(planned, &volumes.CreateVolumeInput{Type: volumeType, Size: v.Size, Name: v.Name})
+	}
+
+	hourly := []Amount{c.Hourly}
+	for _, v := range planned {
+		v.LocationCode = input.LocationCode
+		vc, err := e.EstimateVolume(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		c.Volumes = append(c.Volumes, vc)
+		hourly = append(hourly, vc.Hourly)
+	}
+
+	if c.TotalHourly, err = Sum(hourly...); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// SpendLine is the hourly cost of a single resource in a SpendProjection.
+type SpendLine struct {
+	// Resource is either "instance" or "volume"
+	Resource string
+	ID       string
+	Name     string
+	Hourly   Amount
+}
+
+// SpendProjection projects the current spend of an account.
+type SpendProjection struct {
+	// Lines are the costs of the running instances followed by those of the
+	// attached volumes
+	Lines []*SpendLine
+
+	// Unpriced lists the running instances whose instance type is not
+	// listed, so that neither their price nor the currency of the price
+	// returned by the API is known. They are left out of the totals.
+	Unpriced []*SpendLine
+
+	InstancesHourly Amount
+	VolumesHourly   Amount
+
+	Hourly  Amount
+	Daily   Amount
+	Monthly Amount
+
+	// Time is when the projection was made
+	Time time.Time
+}
+
+// ProjectSpend returns the hourly, daily and monthly spend of the running
+// instances and attached volumes, assuming they keep running at their
+// current price. Volumes are priced with their long-term discount, if any.
+// Instances that cannot be priced are listed in Unpriced instead of failing
+// the projection.
+// An error with the ErrCodeCurrencyMismatch code is returned if resources
+// are billed in different currencies.
+func (e *Estimator) ProjectSpend(ctx context.Context) (*SpendProjection, error) {
+	instances, err := e.Instances.ListInstancesWithContext(ctx,
+		&instance.ListInstancesInput{Status: instance.InstanceStatusRunning})
+	if err != nil {
+		return nil, err
+	}
+	vols, err := e.Volumes.ListVolumesWithContext(ctx,
+		&volumes.ListVolumesStatus{Status: volumes.VolumeStatusAttached})
+	if err != nil {
+		return nil, err
+	}
+
+	p := &SpendProjection{Time: time.Now()}
+
+	var instancesHourly []Amount
+	for _, inst := range instances {
+		if inst == nil || inst.Status != instance.InstanceStatusRunning {
+			continue
+		}
+		hourly, ok, err := e.runningInstanceHourly(ctx, inst)
+		if err != nil {
+			return nil, err
+		}
+		line := &SpendLine{Resource: "instance", ID: inst.ID, Name: inst.Hostname, Hourly: hourly}
+		if !ok {
+			p.Unpriced = append(p.Unpriced, line)
+			continue
+		}
+		p.Lines = append(p.Lines, line)
+		instancesHourly = append(instancesHourly, hourly)
+	}
+
+	var volumesHourly []Amount
+	for _, v := range vols {
+		if v == nil || v.Status != volumes.VolumeStatusAttached {
+			continue
+		}
+		vc, err := e.EstimateExistingVolume(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+		p.Lines = append(p.Lines, &SpendLine{Resource: "volume", ID: v.ID, Name: v.Name, Hourly: vc.DiscountedHourly})
+		volumesHourly = append(volumesHourly, vc.DiscountedHourly)
+	}
+
+	if p.InstancesHourly, err = Sum(instancesHourly...); err != nil {
+		return nil, err
+	}
+	if p.VolumesHourly, err = Sum(volumesHourly...); err != nil {
+		return nil, err
+	}
+	if p.Hourly, err = p.InstancesHourly.Add(p.VolumesHourly); err != nil {
+		return nil, err
+	}
+	p.Daily = p.Hourly.Mul(24)
+	p.Monthly = p.Hourly.Mul(HoursPerMonth)
+	return p, nil
+}
+
+// runningInstanceHourly returns the hourly price of a running instance. The
+// price returned by the API for the instance is used when present, in the
+// currency of its instance type, the listed prices of its instance type
+// otherwise. False is returned if the instance type is not listed, as the
+// instance then cannot be priced in a known currency.
+func (e *Estimator) runningInstanceHourly(ctx context.Context, inst *instance.ListInstancesResponse) (Amount, bool, error) {
+	prices, ok, err := e.instanceTypePrices(ctx, inst.InstanceType)
+	if err != nil || !ok {
+		return Amount{}, false, err
+	}
+	if inst.PricePerHour > 0 {
+		return NewAmount(inst.PricePerHour, prices.PricePerHour.Currency()), true, nil
+	}
+	if inst.IsSpot {
+		return prices.SpotPrice, true, nil
+	}
+	if inst.Pricing == instance.PricingDynamicPrice {
+		return prices.DynamicPrice, true, nil
+	}
+	return prices.PricePerHour, true, nil
+}
+
+// instanceTypePrices returns the prices of the listed instance type with the
+// given name. False is returned if it is not listed.
+func (e *Estimator) instanceTypePrices(ctx context.Context, name string) (*InstanceTypePrices, bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.instanceTypes == nil || time.Since(e.instanceTypesTime) > e.cacheTTL() {
+		types, err := e.InstanceTypes.ListInstanceTypesWithContext(ctx)
+		if err != nil {
+			return nil, false, err
+		}
+		e.instanceTypes = make(map[string]*InstanceTypePrices, len(types))
+		for _, t := range types {
+			if t == nil {
+				continue
+			}
+			prices, err := ParseInstanceTypePrices(t)
+			if err != nil {
+				logger.Debug("cost: skipping instance type %s: %v", t.InstanceType, err)
+				continue
+			}
+			e.instanceTypes[t.InstanceType] = prices
+		}
+		e.instanceTypesTime = time.Now()
+	}
+
+	prices, ok := e.instanceTypes[name]
+	return prices, ok, nil
+}
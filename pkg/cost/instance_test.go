@@ -0,0 +1,205 @@
+package cost
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instance"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instancetypes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumes"
+)
+
+type fakeAccount struct {
+	types     []*instancetypes.InstanceTypeResponse
+	typesErr  error
+	instances []*instance.ListInstancesResponse
+	volumes   []*volumes.VolumeResponse
+}
+
+func (f *fakeAccount) ListInstanceTypesWithContext(context.Context, ...request.Option) ([]*instancetypes.InstanceTypeResponse, error) {
+	return f.types, f.typesErr
+}
+
+func (f *fakeAccount) ListInstancesWithContext(context.Context, *instance.ListInstancesInput, ...request.Option) ([]*instance.ListInstancesResponse, error) {
+	return f.instances, nil
+}
+
+func (f *fakeAccount) ListVolumesWithContext(context.Context, *volumes.ListVolumesStatus, ...request.Option) ([]*volumes.VolumeResponse, error) {
+	return f.volumes, nil
+}
+
+func newTestInstanceEstimator(account *fakeAccount) *Estimator {
+	e, _ := newTestEstimator()
+	account.types = []*instancetypes.InstanceTypeResponse{
+		{
+			InstanceType:    "1A100.22V",
+			PricePerHour:    "1.29",
+			SpotPrice:       "0.45",
+			DynamicPrice:    "0.99",
+			MaxDynamicPrice: "1.49",
+			Currency:        "usd",
+		},
+		{InstanceType: "1V100.6V", PricePerHour: "0.39", Currency: "usd"},
+	}
+	e.InstanceTypes = account
+	e.Instances = account
+	e.Volumes = account
+	return e
+}
+
+func TestParseInstanceTypePrices(t *testing.T) {
+	prices, err := ParseInstanceTypePrices(&instancetypes.InstanceTypeResponse{
+		InstanceType: "1A100.22V",
+		PricePerHour: "1.29",
+		SpotPrice:    "0.45",
+		Currency:     "usd",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if prices.PricePerHour.String() != "1.29 USD" || prices.SpotPrice.String() != "0.45 USD" || !prices.DynamicPrice.IsZero() {
+		t.Errorf("unexpected prices %+v", prices)
+	}
+
+	if _, err := ParseInstanceTypePrices(&instancetypes.InstanceTypeResponse{PricePerHour: "n/a"}); err == nil {
+		t.Error("expected error for invalid price")
+	}
+}
+
+func TestEstimateInstance(t *testing.T) {
+	e := newTestInstanceEstimator(&fakeAccount{})
+
+	c, err := e.EstimateInstance(context.Background(), &instance.CreateInstanceInput{
+		InstanceType: "1A100.22V",
+		LocationCode: "FIN-01",
+		OSVolume:     &instance.OSVolume{Name: "os", Size: 73},
+		Volumes:      []instance.Volume{{Name: "data", Size: 730, Type: "HDD"}},
+		Pricing:      instance.PricingFixedPrice,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// 1.29 + 73 * 0.2 / 730 + 730 * 0.05 / 730
+	if c.Hourly.Decimal() != "1.29" || c.TotalHourly.String() != "1.36 USD" || len(c.Volumes) != 2 {
+		t.Errorf("unexpected cost: hourly %s, total %s, %d volumes", c.Hourly, c.TotalHourly, len(c.Volumes))
+	}
+
+	c, err = e.EstimateInstance(context.Background(), &instance.CreateInstanceInput{
+		InstanceType: "1A100.22V",
+		Pricing:      instance.PricingDynamicPrice,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Hourly.Decimal() != "0.99" || c.MaxHourly.Decimal() != "1.49" {
+		t.Errorf("unexpected dynamic cost: hourly %s, max %s", c.Hourly, c.MaxHourly)
+	}
+
+	c, err = e.EstimateInstance(context.Background(), &instance.CreateInstanceInput{
+		InstanceType: "1A100.22V",
+		IsSpot:       true,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Hourly.Decimal() != "0.45" {
+		t.Errorf("expected spot price 0.45, got %s", c.Hourly)
+	}
+
+	if _, err := e.EstimateInstance(context.Background(), &instance.CreateInstanceInput{InstanceType: "8H100"}); err == nil {
+		t.Error("expected error for unknown instance type")
+	}
+}
+
+func TestProjectSpend(t *testing.T) {
+	e := newTestInstanceEstimator(&fakeAccount{
+		instances: []*instance.ListInstancesResponse{
+			{ID: "i-1", InstanceType: "1A100.22V", Status: instance.InstanceStatusRunning, PricePerHour: 1.29},
+			{ID: "i-2", InstanceType: "1V100.6V", Status: instance.InstanceStatusRunning},
+			{ID: "i-3", InstanceType: "1V100.6V", Status: instance.InstanceStatusOffline},
+		},
+		volumes: []*volumes.VolumeResponse{
+			{ID: "v-1", Type: volumes.VolumeTypeNVMe, Size: 365, Status: volumes.VolumeStatusAttached},
+			{ID: "v-2", Type: volumes.VolumeTypeNVMe, Size: 365, Status: volumes.VolumeStatusDetached},
+		},
+	})
+
+	p, err := e.ProjectSpend(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(p.Lines) != 3 {
+		t.Fatalf("expected 2 instances and 1 volume, got %d lines", len(p.Lines))
+	}
+	// 1.29 + 0.39 + 365 * 0.2 / 730
+	if p.InstancesHourly.Decimal() != "1.68" || p.VolumesHourly.Decimal() != "0.10" {
+		t.Errorf("unexpected hourly spend: instances %s, volumes %s", p.InstancesHourly, p.VolumesHourly)
+	}
+	if p.Hourly.String() != "1.78 USD" || p.Daily.Decimal() != "42.72" || p.Monthly.Decimal() != "1299.40" {
+		t.Errorf("unexpected spend: hourly %s, daily %s, monthly %s", p.Hourly, p.Daily, p.Monthly)
+	}
+}
+
+func TestProjectSpend_InstancePrices(t *testing.T) {
+	account := &fakeAccount{
+		instances: []*instance.ListInstancesResponse{
+			// The API price wins over the listed one
+			{ID: "i-1", InstanceType: "1A100.22V", Status: instance.InstanceStatusRunning, PricePerHour: 2},
+			// Listed spot price
+			{ID: "i-2", InstanceType: "1A100.22V", Status: instance.InstanceStatusRunning, IsSpot: true},
+			// Unlisted type with an API price in an unknown currency
+			{ID: "i-3", InstanceType: "retired", Hostname: "legacy-api", Status: instance.InstanceStatusRunning, PricePerHour: 0.5},
+			// Unlisted type without an API price
+			{ID: "i-4", InstanceType: "retired", Hostname: "legacy", Status: instance.InstanceStatusRunning},
+		},
+	}
+	e := newTestInstanceEstimator(account)
+
+	p, err := e.ProjectSpend(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hourly := map[string]string{}
+	for _, line := range p.Lines {
+		hourly[line.ID] = line.Hourly.Decimal()
+	}
+	expected := map[string]string{"i-1": "2.00", "i-2": "0.45"}
+	for id, price := range expected {
+		if hourly[id] != price {
+			t.Errorf("%s: expected %s per hour, got %q", id, price, hourly[id])
+		}
+	}
+	if len(p.Lines) != 2 {
+		t.Errorf("expected 2 priced lines, got %d", len(p.Lines))
+	}
+	if len(p.Unpriced) != 2 || p.Unpriced[0].ID != "i-3" || p.Unpriced[1].ID != "i-4" || p.Unpriced[1].Name != "legacy" {
+		t.Errorf("expected i-3 and i-4 to be unpriced, got %+v", p.Unpriced)
+	}
+	if p.Hourly.String() != "2.45 USD" {
+		t.Errorf("expected 2.45 USD per hour, got %s", p.Hourly)
+	}
+}
+
+func TestProjectSpend_InstanceTypesError(t *testing.T) {
+	listErr := errors.New("instance types unavailable")
+	account := &fakeAccount{
+		instances: []*instance.ListInstancesResponse{
+			{ID: "i-1", InstanceType: "1A100.22V", Status: instance.InstanceStatusRunning, PricePerHour: 1.29},
+		},
+	}
+	e := newTestInstanceEstimator(account)
+	account.typesErr = listErr
+
+	// Instances priced by the API still need the currency of their type
+	if _, err := e.ProjectSpend(context.Background()); err != listErr {
+		t.Errorf("expected the instance types error, got %v", err)
+	}
+
+	account.instances[0].PricePerHour = 0
+	if _, err := e.ProjectSpend(context.Background()); err != listErr {
+		t.Errorf("expected the instance types error, got %v", err)
+	}
+}
@@ -0,0 +1,145 @@
+package cost
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumetypes"
+)
+
+// VolumeTypesAPI lists volume types and their prices. It is satisfied by the
+// volumetypes client.
+type VolumeTypesAPI interface {
+	ListVolumeTypesWithContext(ctx context.Context, opts ...request.Option) ([]*volumetypes.VolumeTypeResponse, error)
+}
+
+// VolumeCost is the estimated cost of a volume.
+type VolumeCost struct {
+	Type         volumes.VolumeType
+	Size         int64
+	LocationCode string
+
+	// Hourly and Monthly are the pay-as-you-go prices of the volume
+	Hourly  Amount
+	Monthly Amount
+
+	// DiscountPercentage is the long-term discount of the volume, zero for
+	// pay-as-you-go volumes
+	DiscountPercentage float64
+
+	// DiscountedHourly and DiscountedMonthly are the prices with the
+	// long-term discount applied
+	DiscountedHourly  Amount
+	DiscountedMonthly Amount
+}
+
+// WithDiscount returns a copy of the cost with a long-term discount of the
+// given percentage, to price a volume under a long-term contract.
+func (c *VolumeCost) WithDiscount(percentage float64) *VolumeCost {
+	discounted := *c
+	discounted.DiscountPercentage = percentage
+	discounted.DiscountedHourly = c.Hourly.Discount(percentage)
+	discounted.DiscountedMonthly = c.Monthly.Discount(percentage)
+	return &discounted
+}
+
+// EstimateVolume returns the cost of the volume described by a create input,
+// before it is created.
+func (e *Estimator) EstimateVolume(ctx context.Context, input *volumes.CreateVolumeInput) (*VolumeCost, error) {
+	if input == nil {
+		input = &volumes.CreateVolumeInput{}
+	}
+	if input.Size <= 0 {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter,
+			fmt.Sprintf("volume size must be positive, got %d", input.Size), nil)
+	}
+
+	t, err := e.volumeType(ctx, input.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	monthly := NewAmount(t.Price.PricePerMonthPerGB, t.Price.Currency).Mul(input.Size)
+	c := &VolumeCost{
+		Type:         input.Type,
+		Size:         input.Size,
+		LocationCode: input.LocationCode,
+		Hourly:       monthly.Div(HoursPerMonth),
+		Monthly:      monthly,
+	}
+	return c.WithDiscount(0), nil
+}
+
+// EstimateExistingVolume returns the cost of an existing volume. The prices
+// returned by the API for the volume are used when present, the prices of
+// its volume type otherwise. The long-term discount of the volume, if any,
+// is applied to the discounted prices.
+func (e *Estimator) EstimateExistingVolume(ctx context.Context, v *volumes.VolumeResponse) (*VolumeCost, error) {
+	if v == nil {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter, "a volume is required", nil)
+	}
+
+	var c *VolumeCost
+	if v.MonthlyPrice > 0 || v.BaseHourlyCost > 0 {
+		c = &VolumeCost{
+			Type:         v.Type,
+			Size:         v.Size,
+			LocationCode: v.Location,
+			Hourly:       NewAmount(v.BaseHourlyCost, v.Currency),
+			Monthly:      NewAmount(v.MonthlyPrice, v.Currency),
+		}
+		if v.BaseHourlyCost <= 0 {
+			c.Hourly = c.Monthly.Div(HoursPerMonth)
+		}
+		if v.MonthlyPrice <= 0 {
+			c.Monthly = c.Hourly.Mul(HoursPerMonth)
+		}
+	} else {
+		var err error
+		c, err = e.EstimateVolume(ctx, &volumes.CreateVolumeInput{
+			Type:         v.Type,
+			LocationCode: v.Location,
+			Size:         v.Size,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var discount float64
+	if v.LongTerm != nil {
+		discount = v.LongTerm.DiscountPercentage
+	}
+	return c.WithDiscount(discount), nil
+}
+
+// volumeType returns the listed volume type with the given name.
+func (e *Estimator) volumeType(ctx context.Context, name volumes.VolumeType) (*volumetypes.VolumeTypeResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.volumeTypes == nil || time.Since(e.volumeTypesTime) > e.cacheTTL() {
+		types, err := e.VolumeTypes.ListVolumeTypesWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		e.volumeTypes = make(map[volumes.VolumeType]*volumetypes.VolumeTypeResponse, len(types))
+		for _, t := range types {
+			if t != nil {
+				e.volumeTypes[volumes.VolumeType(t.Type)] = t
+			}
+		}
+		e.volumeTypesTime = time.Now()
+	}
+
+	t, ok := e.volumeTypes[name]
+	if !ok {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter,
+			fmt.Sprintf("unknown volume type %q", name), nil)
+	}
+	return t, nil
+}
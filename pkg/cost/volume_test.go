@@ -0,0 +1,93 @@
+package cost
+
+import (
+	"context"
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumetypes"
+)
+
+type fakeVolumeTypes struct {
+	types []*volumetypes.VolumeTypeResponse
+	calls int
+}
+
+func (f *fakeVolumeTypes) ListVolumeTypesWithContext(context.Context, ...request.Option) ([]*volumetypes.VolumeTypeResponse, error) {
+	f.calls++
+	return f.types, nil
+}
+
+func newTestEstimator() (*Estimator, *fakeVolumeTypes) {
+	types := &fakeVolumeTypes{
+		types: []*volumetypes.VolumeTypeResponse{
+			{Type: "NVMe", Price: volumetypes.Price{PricePerMonthPerGB: 0.2, Currency: "usd"}},
+			{Type: "HDD", Price: volumetypes.Price{PricePerMonthPerGB: 0.05, Currency: "usd"}},
+		},
+	}
+	return &Estimator{VolumeTypes: types}, types
+}
+
+func TestEstimateVolume(t *testing.T) {
+	e, types := newTestEstimator()
+
+	c, err := e.EstimateVolume(context.Background(), &volumes.CreateVolumeInput{
+		Type:         volumes.VolumeTypeNVMe,
+		LocationCode: "FIN-01",
+		Size:         730,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Monthly.String() != "146.00 USD" || c.Hourly.String() != "0.20 USD" {
+		t.Errorf("unexpected cost: monthly %s, hourly %s", c.Monthly, c.Hourly)
+	}
+	if c.DiscountedMonthly != c.Monthly {
+		t.Errorf("expected no discount, got %s", c.DiscountedMonthly)
+	}
+	if got := c.WithDiscount(10).DiscountedMonthly.Decimal(); got != "131.40" {
+		t.Errorf("expected 131.40 with a 10%% discount, got %s", got)
+	}
+
+	if _, err := e.EstimateVolume(context.Background(), &volumes.CreateVolumeInput{Type: "HDD", Size: 100}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if types.calls != 1 {
+		t.Errorf("expected volume types to be listed once, got %d", types.calls)
+	}
+
+	if _, err := e.EstimateVolume(context.Background(), &volumes.CreateVolumeInput{Type: "SSD", Size: 100}); err == nil {
+		t.Error("expected error for unknown volume type")
+	}
+	if _, err := e.EstimateVolume(context.Background(), &volumes.CreateVolumeInput{Type: "HDD"}); err == nil {
+		t.Error("expected error for missing size")
+	}
+}
+
+func TestEstimateExistingVolume(t *testing.T) {
+	e, _ := newTestEstimator()
+
+	c, err := e.EstimateExistingVolume(context.Background(), &volumes.VolumeResponse{
+		Type:         volumes.VolumeTypeNVMe,
+		Size:         500,
+		MonthlyPrice: 90,
+		Currency:     "usd",
+		LongTerm:     &volumes.LongTerm{DiscountPercentage: 20},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Monthly.Decimal() != "90.00" || c.DiscountedMonthly.Decimal() != "72.00" {
+		t.Errorf("unexpected cost: monthly %s, discounted %s", c.Monthly, c.DiscountedMonthly)
+	}
+
+	// Priced from the volume type when the API returns no price
+	c, err = e.EstimateExistingVolume(context.Background(), &volumes.VolumeResponse{Type: "HDD", Size: 100})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.Monthly.String() != "5.00 USD" {
+		t.Errorf("expected 5.00 USD, got %s", c.Monthly)
+	}
+}
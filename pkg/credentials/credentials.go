@@ -80,6 +80,9 @@ type Credentials struct {
 	creds    Value
 	provider Provider
 	mu       sync.RWMutex
+
+	tokenSource     *OAuth2Credentials
+	tokenSourceOnce sync.Once
 }
 
 // NewCredentials returns a new Credentials with the given provider
@@ -144,6 +147,16 @@ func (c *Credentials) Expire() {
 	c.creds = Value{}
 }
 
+// TokenSource returns the OAuth2 token source shared by every request made
+// with these credentials. It is created on first use, so all service clients
+// created from the same session reuse one cached access token.
+func (c *Credentials) TokenSource() *OAuth2Credentials {
+	c.tokenSourceOnce.Do(func() {
+		c.tokenSource = NewOAuth2CredentialsFromProvider(c)
+	})
+	return c.tokenSource
+}
+
 // GetClientCredentials returns just the client ID and secret for basic OAuth2 flows
 func (c *Credentials) GetClientCredentials() (clientID, clientSecret string, err error) {
 	creds, err := c.Get()
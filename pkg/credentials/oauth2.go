@@ -14,8 +14,25 @@ import (
 	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
 )
 
+const (
+	// tokenExpiryWindow is how long before expiry a cached token stops being
+	// handed out and callers wait for a new one.
+	tokenExpiryWindow = time.Minute
+
+	// tokenRefreshWindow is how long before expiry a background refresh is
+	// started while the cached token is still served.
+	tokenRefreshWindow = 5 * time.Minute
+
+	// tokenRequestTimeout bounds a token request. The request is shared by
+	// every caller waiting on it, so it is detached from their contexts.
+	tokenRequestTimeout = 30 * time.Second
+)
+
 // OAuth2Credentials represents OAuth2 client credentials with token caching
 // This is now a wrapper around the new credential system
+//
+// OAuth2Credentials is safe for concurrent use. Concurrent callers needing a
+// new token share a single token request.
 type OAuth2Credentials struct {
 	creds *Credentials
 
@@ -24,7 +41,18 @@ type OAuth2Credentials struct {
 	RefreshToken string
 	Expiry       time.Time
 
-	mu sync.Mutex
+	// HTTPClient is used for token requests. If nil, http.DefaultClient is used.
+	HTTPClient *http.Client
+
+	mu       sync.Mutex
+	inflight *tokenCall
+}
+
+// tokenCall is an in-flight token request shared by concurrent callers
+type tokenCall struct {
+	done  chan struct{}
+	token string
+	err   error
 }
 
 // TokenResponse matches the OAuth2 token endpoint response
@@ -53,21 +81,102 @@ func NewOAuth2CredentialsFromProvider(creds *Credentials) *OAuth2Credentials {
 
 // GetToken returns a valid access token, refreshing or fetching as needed
 func (c *OAuth2Credentials) GetToken(ctx context.Context) (string, error) {
+	return c.GetTokenWithClient(ctx, c.HTTPClient)
+}
+
+// GetTokenWithClient is the same as GetToken but sends any token request
+// needed with the given HTTP client.
+//
+// A cached token close to expiry is still returned while a refresh runs in
+// the background. Once the token is within a minute of expiry, callers wait
+// for the refresh to complete.
+func (c *OAuth2Credentials) GetTokenWithClient(ctx context.Context, client *http.Client) (string, error) {
 	c.mu.Lock()
-	defer c.mu.Unlock()
 
-	// If token is still valid, return it
-	if c.AccessToken != "" && time.Now().Before(c.Expiry.Add(-time.Minute)) {
+	now := time.Now()
+	if c.AccessToken != "" && now.Before(c.Expiry.Add(-tokenExpiryWindow)) {
+		token := c.AccessToken
 		logger.Debug("Using cached token, expires at: %v", c.Expiry)
-		return c.AccessToken, nil
+		if now.After(c.Expiry.Add(-tokenRefreshWindow)) && c.inflight == nil {
+			logger.Debug("Token expires soon, refreshing in background")
+			c.startTokenRequestLocked(ctx, client)
+		}
+		c.mu.Unlock()
+		return token, nil
+	}
+
+	call := c.inflight
+	if call == nil {
+		call = c.startTokenRequestLocked(ctx, client)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-call.done:
+		return call.token, call.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// InvalidateToken discards the cached access and refresh tokens so the next
+// GetToken call obtains a new token with the client credentials grant. If
+// token is not empty the cache is only discarded while it still holds that
+// token, so concurrent callers rejecting the same token trigger one fetch.
+func (c *OAuth2Credentials) InvalidateToken(token string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if token != "" && token != c.AccessToken {
+		return
 	}
 
+	logger.Debug("Invalidating cached OAuth2 token")
+	c.AccessToken = ""
+	c.RefreshToken = ""
+	c.Expiry = time.Time{}
+}
+
+// startTokenRequestLocked starts a token request in the background and
+// records it as in flight. The caller must hold c.mu.
+func (c *OAuth2Credentials) startTokenRequestLocked(ctx context.Context, client *http.Client) *tokenCall {
+	call := &tokenCall{done: make(chan struct{})}
+	c.inflight = call
+	refreshToken := c.RefreshToken
+
+	go func() {
+		reqCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), tokenRequestTimeout)
+		defer cancel()
+
+		tokenResp, err := c.fetchToken(reqCtx, client, refreshToken)
+
+		c.mu.Lock()
+		if err == nil {
+			c.AccessToken = tokenResp.AccessToken
+			c.RefreshToken = tokenResp.RefreshToken
+			c.Expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+			call.token = tokenResp.AccessToken
+			logger.Debug("Token obtained, expires in %d seconds", tokenResp.ExpiresIn)
+		}
+		call.err = err
+		c.inflight = nil
+		c.mu.Unlock()
+
+		close(call.done)
+	}()
+
+	return call
+}
+
+// fetchToken obtains a new token, preferring the refresh token grant and
+// falling back to client credentials.
+func (c *OAuth2Credentials) fetchToken(ctx context.Context, client *http.Client, refreshToken string) (*TokenResponse, error) {
 	// If we have a refresh token, try to refresh
-	if c.RefreshToken != "" {
+	if refreshToken != "" {
 		logger.Debug("Attempting to refresh token")
-		var err error
-		if err = c.refreshWithRefreshToken(ctx); err == nil {
-			return c.AccessToken, nil
+		tokenResp, err := c.refreshWithRefreshToken(ctx, client, refreshToken)
+		if err == nil {
+			return tokenResp, nil
 		}
 		logger.Debug("Refresh failed, falling back to client credentials: %v", err)
 		// If refresh fails, fall back to client credentials
@@ -75,10 +184,7 @@ func (c *OAuth2Credentials) GetToken(ctx context.Context) (string, error) {
 
 	// Otherwise, get a new token using client credentials
 	logger.Debug("Fetching new token using client credentials")
-	if err := c.fetchWithClientCredentials(ctx); err != nil {
-		return "", err
-	}
-	return c.AccessToken, nil
+	return c.fetchWithClientCredentials(ctx, client)
 }
 
 // GetClientCredentials returns the client credentials for basic OAuth2 flows
@@ -96,10 +202,10 @@ func (c *OAuth2Credentials) GetBaseURL() (string, error) {
 }
 
 // fetchWithClientCredentials gets a new token using client credentials grant
-func (c *OAuth2Credentials) fetchWithClientCredentials(ctx context.Context) error {
+func (c *OAuth2Credentials) fetchWithClientCredentials(ctx context.Context, client *http.Client) (*TokenResponse, error) {
 	clientID, clientSecret, err := c.creds.GetClientCredentials()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	payload := map[string]string{
@@ -107,34 +213,34 @@ func (c *OAuth2Credentials) fetchWithClientCredentials(ctx context.Context) erro
 		"client_id":     clientID,
 		"client_secret": clientSecret,
 	}
-	return c.doTokenRequest(ctx, payload)
+	return c.doTokenRequest(ctx, client, payload)
 }
 
 // refreshWithRefreshToken gets a new token using the refresh token grant
-func (c *OAuth2Credentials) refreshWithRefreshToken(ctx context.Context) error {
-	if c.RefreshToken == "" {
-		return errors.New("no refresh token available")
+func (c *OAuth2Credentials) refreshWithRefreshToken(ctx context.Context, client *http.Client, refreshToken string) (*TokenResponse, error) {
+	if refreshToken == "" {
+		return nil, errors.New("no refresh token available")
 	}
 
 	clientID, clientSecret, err := c.creds.GetClientCredentials()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	payload := map[string]string{
 		"grant_type":    "refresh_token",
-		"refresh_token": c.RefreshToken,
+		"refresh_token": refreshToken,
 		"client_id":     clientID,
 		"client_secret": clientSecret,
 	}
-	return c.doTokenRequest(ctx, payload)
+	return c.doTokenRequest(ctx, client, payload)
 }
 
-// doTokenRequest sends the token request and updates the credential fields
-func (c *OAuth2Credentials) doTokenRequest(ctx context.Context, payload map[string]string) error {
+// doTokenRequest sends the token request and returns the decoded response
+func (c *OAuth2Credentials) doTokenRequest(ctx context.Context, client *http.Client, payload map[string]string) (*TokenResponse, error) {
 	baseURL, err := c.GetBaseURL()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	body, _ := json.Marshal(payload)
@@ -144,14 +250,17 @@ func (c *OAuth2Credentials) doTokenRequest(ctx context.Context, payload map[stri
 
 	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewBuffer(body))
 	if err != nil {
-		return err
+		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Accept", "application/json")
 
-	resp, err := http.DefaultClient.Do(req)
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
@@ -162,23 +271,18 @@ func (c *OAuth2Credentials) doTokenRequest(ctx context.Context, payload map[stri
 	// Read and log the response body
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	logger.Debug("Token response status: %d", resp.StatusCode)
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("token endpoint returned %d: %s", resp.StatusCode, string(respBody))
+		return nil, fmt.Errorf("token endpoint returned %d: %s", resp.StatusCode, string(respBody))
 	}
 
 	var tokenResp TokenResponse
 	if err := json.Unmarshal(respBody, &tokenResp); err != nil {
-		return err
+		return nil, err
 	}
 
-	c.AccessToken = tokenResp.AccessToken
-	c.RefreshToken = tokenResp.RefreshToken
-	c.Expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
-	logger.Debug("Token obtained, expires in %d seconds", tokenResp.ExpiresIn)
-
-	return nil
+	return &tokenResp, nil
 }
@@ -0,0 +1,103 @@
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTokenServer(t *testing.T, calls *int32, expiresIn int) *httptest.Server {
+	t.Helper()
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/oauth2/token" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		n := atomic.AddInt32(calls, 1)
+
+		// Give concurrent callers time to pile up behind the first request
+		time.Sleep(20 * time.Millisecond)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(TokenResponse{
+			AccessToken: "token-" + string(rune('0'+n)),
+			ExpiresIn:   expiresIn,
+		})
+	}))
+}
+
+func TestTokenSource_SharedAcrossCallers(t *testing.T) {
+	var calls int32
+	server := newTokenServer(t, &calls, 3600)
+	defer server.Close()
+
+	creds := NewStaticCredentials("id", "secret", server.URL)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := creds.TokenSource().GetToken(context.Background())
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if token != "token-1" {
+				t.Errorf("expected token-1, got %s", token)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected a single token request, got %d", got)
+	}
+
+	if creds.TokenSource() != creds.TokenSource() {
+		t.Error("expected TokenSource to return the same instance")
+	}
+}
+
+func TestTokenSource_RefreshesBeforeExpiry(t *testing.T) {
+	var calls int32
+	server := newTokenServer(t, &calls, 3600)
+	defer server.Close()
+
+	src := NewOAuth2Credentials("id", "secret", server.URL)
+	src.AccessToken = "cached"
+	src.Expiry = time.Now().Add(2 * time.Minute)
+
+	token, err := src.GetToken(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "cached" {
+		t.Errorf("expected cached token while refreshing in background, got %s", token)
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for atomic.LoadInt32(&calls) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Fatal("expected a background token refresh")
+	}
+
+	// Wait for the background refresh to land
+	deadline = time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if token, _ = src.GetToken(context.Background()); token != "cached" {
+			break
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if token != "token-1" {
+		t.Errorf("expected refreshed token, got %s", token)
+	}
+}
@@ -22,6 +22,22 @@ func New(code, message string, origErr error) Error {
 	return newBaseError(code, message, errs)
 }
 
+// BatchedErrors is a batch of errors which also wraps lower level errors with
+// code, message, and original errors. Calling Error() will include all errors
+// that occurred in the batch.
+type BatchedErrors interface {
+	Error
+
+	// Returns all original errors that occurred in the batch.
+	OrigErrs() []error
+}
+
+// NewBatchError returns an BatchedErrors with a collection of errors as an
+// array of errors.
+func NewBatchError(code, message string, errs []error) BatchedErrors {
+	return newBaseError(code, message, errs)
+}
+
 type RequestFailure interface {
 	Error
 
@@ -30,6 +30,39 @@ type RequestInfo struct {
 	RequestBody    []byte
 }
 
+// Error codes returned by the DataCrunch API in the "code" field of
+// APIErrorResponse.
+const (
+	// APIErrCodeInvalidRequest is returned for malformed or invalid requests.
+	APIErrCodeInvalidRequest = "invalid_request"
+
+	// APIErrCodeUnauthorizedRequest is returned when the access token is
+	// missing, expired or revoked.
+	APIErrCodeUnauthorizedRequest = "unauthorized_request"
+
+	// APIErrCodeInsufficientFunds is returned when the account balance does
+	// not cover the requested resources.
+	APIErrCodeInsufficientFunds = "insufficient_funds"
+
+	// APIErrCodeForbiddenAction is returned when the action is not allowed
+	// for the account or the resource.
+	APIErrCodeForbiddenAction = "forbidden_action"
+
+	// APIErrCodeNotFound is returned when the resource does not exist.
+	APIErrCodeNotFound = "not_found"
+
+	// APIErrCodeServerError is returned for unexpected server side failures.
+	APIErrCodeServerError = "server_error"
+
+	// APIErrCodeServiceUnavailable is returned when the API is temporarily
+	// unable to handle the request.
+	APIErrCodeServiceUnavailable = "service_unavailable"
+
+	// APIErrCodeRateLimitExceeded is returned when too many requests were
+	// made in a short period of time.
+	APIErrCodeRateLimitExceeded = "rate_limit_exceeded"
+)
+
 // APIErrorResponse represents the standard DataCrunch API error format
 type APIErrorResponse struct {
 	Code    string `json:"code"`
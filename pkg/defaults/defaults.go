@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"net/http"
+	"strings"
 
 	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/util"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/credentials"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
@@ -21,6 +24,11 @@ func Handlers() request.Handlers {
 	})
 
 	handlers.Build.PushBackNamed(request.NamedHandler{
+		Name: "core.IdempotencyKeyHandler",
+		Fn:   IdempotencyKeyHandler,
+	})
+
+	handlers.Sign.PushBackNamed(request.NamedHandler{
 		Name: "core.OAuth2AuthHandler",
 		Fn:   OAuth2AuthHandler,
 	})
@@ -54,6 +62,28 @@ func ValidateCredentialsHandler(r *request.Request) {
 	}
 }
 
+// IdempotencyKeyHandler attaches a generated idempotency key to POST and PUT
+// requests that were not given one. The request is only built once, so every
+// retry is sent with the same key. Generated keys do not make the request
+// eligible for retries after ambiguous failures, see Request.IsIdempotent.
+func IdempotencyKeyHandler(r *request.Request) {
+	switch r.HTTPRequest.Method {
+	case http.MethodPost, http.MethodPut:
+	default:
+		return
+	}
+	if r.IdempotencyKey() != "" {
+		return
+	}
+
+	key, err := util.NewUUID()
+	if err != nil {
+		r.Error = dcerr.New(request.ErrCodeRequestError, "failed to generate idempotency key", err)
+		return
+	}
+	r.SetGeneratedIdempotencyKey(key)
+}
+
 // OAuth2AuthHandler adds OAuth2 authentication to requests using credential chain
 func OAuth2AuthHandler(r *request.Request) {
 	// Get credentials from the request's session
@@ -68,11 +98,18 @@ func OAuth2AuthHandler(r *request.Request) {
 		return
 	}
 
-	// Create OAuth2Credentials wrapper for token management
-	oauth2Creds := credentials.NewOAuth2CredentialsFromProvider(creds)
+	tokenSource := creds.TokenSource()
+
+	// The previous attempt was rejected with HTTP 401, drop the token it was
+	// signed with so a new one is obtained below
+	if r.Reauthenticating {
+		rejected := strings.TrimPrefix(r.HTTPRequest.Header.Get("Authorization"), "Bearer ")
+		tokenSource.InvalidateToken(rejected)
+	}
 
-	// Get a valid access token
-	token, err := oauth2Creds.GetToken(r.Context())
+	// Get a valid access token from the token source shared by every client
+	// using these credentials
+	token, err := tokenSource.GetTokenWithClient(r.Context(), r.Config.HTTPClient)
 	if err != nil {
 		logger.Error("Failed to get OAuth2 token: %v", err)
 		r.Error = err
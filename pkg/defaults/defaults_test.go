@@ -0,0 +1,152 @@
+package defaults
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/config"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/credentials"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+type authServer struct {
+	*httptest.Server
+	tokenCalls   int32
+	apiCalls     int32
+	failTokensAt int32
+}
+
+func newAuthServer(t *testing.T, validToken func(call int32) string) *authServer {
+	t.Helper()
+
+	s := &authServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/token":
+			n := atomic.AddInt32(&s.tokenCalls, 1)
+			if s.failTokensAt != 0 && n >= s.failTokensAt {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(credentials.TokenResponse{
+				AccessToken: "token-" + strconv.Itoa(int(n)),
+				ExpiresIn:   3600,
+			})
+		default:
+			n := atomic.AddInt32(&s.apiCalls, 1)
+			if r.Header.Get("Authorization") != "Bearer "+validToken(n) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"code":"unauthorized_request","message":"Access token is invalid"}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	return s
+}
+
+func newAuthRequest(baseURL string) *request.Request {
+	cfg := config.Config{
+		BaseURL:     &baseURL,
+		Credentials: credentials.NewStaticCredentials("id", "secret", baseURL),
+	}
+	return request.New(cfg, Handlers(), nil,
+		&request.Operation{Name: "Test", HTTPMethod: "GET", HTTPPath: "/test"}, nil, nil)
+}
+
+func TestOAuth2AuthHandler_ReauthenticatesOnUnauthorized(t *testing.T) {
+	// The first token issued is rejected, as if it was revoked server side
+	server := newAuthServer(t, func(int32) string { return "token-2" })
+	defer server.Close()
+
+	if err := newAuthRequest(server.URL).Send(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&server.tokenCalls); got != 2 {
+		t.Errorf("expected 2 token requests, got %d", got)
+	}
+	if got := atomic.LoadInt32(&server.apiCalls); got != 2 {
+		t.Errorf("expected request to be replayed once, got %d calls", got)
+	}
+}
+
+func TestOAuth2AuthHandler_ReplaysOnlyOnce(t *testing.T) {
+	server := newAuthServer(t, func(int32) string { return "never-valid" })
+	defer server.Close()
+
+	err := newAuthRequest(server.URL).Send()
+	if got := dcerr.GetStatusCode(err); got != http.StatusUnauthorized {
+		t.Fatalf("expected 401 HTTPError, got %v", err)
+	}
+	if got := atomic.LoadInt32(&server.apiCalls); got != 2 {
+		t.Errorf("expected exactly 2 attempts, got %d", got)
+	}
+}
+
+func TestOAuth2AuthHandler_ReauthenticationFailure(t *testing.T) {
+	server := newAuthServer(t, func(int32) string { return "token-2" })
+	server.failTokensAt = 2
+	defer server.Close()
+
+	err := newAuthRequest(server.URL).Send()
+	aerr, ok := err.(dcerr.Error)
+	if !ok {
+		t.Fatalf("expected dcerr.Error, got %T: %v", err, err)
+	}
+	if aerr.Code() != request.ErrCodeReauthenticationFailed {
+		t.Errorf("expected %s, got %s", request.ErrCodeReauthenticationFailed, aerr.Code())
+	}
+}
+
+type alwaysRetryer struct{}
+
+func (alwaysRetryer) RetryRules(*request.Request) time.Duration { return 0 }
+func (alwaysRetryer) ShouldRetry(*request.Request) bool         { return true }
+func (alwaysRetryer) MaxRetries() int                           { return 2 }
+
+func TestIdempotencyKeyHandler_ReusesKeyAcrossRetries(t *testing.T) {
+	var keys []string
+	var mu sync.Mutex
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/token" {
+			_ = json.NewEncoder(w).Encode(credentials.TokenResponse{AccessToken: "token", ExpiresIn: 3600})
+			return
+		}
+		mu.Lock()
+		keys = append(keys, r.Header.Get(request.IdempotencyKeyHeader))
+		mu.Unlock()
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	baseURL := server.URL
+	cfg := config.Config{
+		BaseURL:     &baseURL,
+		Credentials: credentials.NewStaticCredentials("id", "secret", baseURL),
+	}
+	req := request.New(cfg, Handlers(), alwaysRetryer{},
+		&request.Operation{Name: "Test", HTTPMethod: "POST", HTTPPath: "/test"}, nil, nil)
+
+	if err := req.Send(); err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if len(keys) != 3 {
+		t.Fatalf("expected 3 attempts, got %d", len(keys))
+	}
+	if keys[0] == "" {
+		t.Fatal("expected an idempotency key to be generated")
+	}
+	for _, key := range keys[1:] {
+		if key != keys[0] {
+			t.Errorf("expected key %s to be reused, got %s", keys[0], key)
+		}
+	}
+}
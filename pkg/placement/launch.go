@@ -0,0 +1,164 @@
+package placement
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instance"
+)
+
+// ErrCodeCapacityUnavailable is the error code returned by Launch when every
+// candidate failed for lack of capacity.
+const ErrCodeCapacityUnavailable = "CapacityUnavailable"
+
+// CapacityErrorCodes are the API error codes reporting that an instance type
+// cannot be launched in a location right now.
+var CapacityErrorCodes = []string{
+	"insufficient_capacity",
+}
+
+// CapacityErrorMessages are the API error messages reporting that an instance
+// type cannot be launched in a location right now. They are compared with
+// the whole message, ignoring case and a trailing period.
+var CapacityErrorMessages = []string{
+	"Not enough resources available for this instance type",
+	"Not enough resources available",
+	"No resources available",
+	"Insufficient capacity",
+	"Out of stock",
+}
+
+// InstanceCreator creates instances. It is satisfied by the instance client.
+type InstanceCreator interface {
+	CreateInstanceWithContext(ctx context.Context, input *instance.CreateInstanceInput, opts ...request.Option) (string, error)
+}
+
+// LaunchFailure records why a candidate could not be launched.
+type LaunchFailure struct {
+	Candidate *Candidate
+	Err       error
+}
+
+// LaunchResult reports the outcome of Launch.
+type LaunchResult struct {
+	// Candidate is the candidate that was launched, nil if none was.
+	Candidate *Candidate
+
+	// InstanceID is the ID of the launched instance.
+	InstanceID string
+
+	// Failures lists the candidates attempted before, in order.
+	Failures []*LaunchFailure
+}
+
+// IsCapacityError returns true if err reports that the instance type could
+// not be launched in the location because of missing capacity, meaning that
+// another candidate may still succeed. Errors are matched on their API error
+// code, or on their message for client errors; server errors such as a bare
+// 503 are not capacity errors.
+func IsCapacityError(err error) bool {
+	httpErr, ok := dcerr.IsHTTPError(err)
+	if !ok {
+		return false
+	}
+
+	code := dcerr.GetAPIErrorCode(err)
+	for _, c := range CapacityErrorCodes {
+		if code == c {
+			return true
+		}
+	}
+	if httpErr.StatusCode >= http.StatusInternalServerError {
+		return false
+	}
+
+	message := strings.TrimSuffix(strings.TrimSpace(dcerr.GetAPIErrorMessage(err)), ".")
+	for _, m := range CapacityErrorMessages {
+		if strings.EqualFold(message, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// Launch attempts CreateInstance with each candidate in order until one
+// succeeds. The instance type, location and spot setting of input are
+// replaced by those of the candidate; input itself is not modified. Spot
+// candidates are launched with the instance.ContractSpot contract, which
+// on-demand candidates replace with instance.ContractPayAsYouGo.
+//
+// Candidates failing with a capacity error (see IsCapacityError) are skipped.
+// Any other error is returned immediately, as the remaining candidates would
+// fail the same way. If every candidate lacks capacity, an error with the
+// ErrCodeCapacityUnavailable code batching each failure is returned. The
+// result always lists the failed attempts.
+//
+// The options are applied to every attempt. An idempotency key given with
+// request.WithIdempotencyKey is suffixed with the instance type, location and
+// spot setting of the candidate, so that an attempt failing for lack of
+// capacity does not make the API replay that failure for the next candidate.
+func Launch(ctx context.Context, creator InstanceCreator, input *instance.CreateInstanceInput, candidates []*Candidate, opts ...request.Option) (*LaunchResult, error) {
+	result := &LaunchResult{}
+	if input == nil || len(candidates) == 0 {
+		return result, dcerr.New(request.ErrCodeInvalidParameter, "an input and at least one candidate are required", nil)
+	}
+
+	var errs []error
+	for _, c := range candidates {
+		attempt := *input
+		attempt.InstanceType = c.InstanceType
+		attempt.LocationCode = c.LocationCode
+		attempt.IsSpot = c.Spot
+		attempt.Contract = candidateContract(input.Contract, c.Spot)
+
+		attemptOpts := append(append([]request.Option{}, opts...),
+			request.WithDerivedIdempotencyKey(candidateKey(c)))
+
+		id, err := creator.CreateInstanceWithContext(ctx, &attempt, attemptOpts...)
+		if err == nil {
+			result.Candidate = c
+			result.InstanceID = id
+			return result, nil
+		}
+
+		result.Failures = append(result.Failures, &LaunchFailure{Candidate: c, Err: err})
+		if !IsCapacityError(err) {
+			return result, err
+		}
+
+		logger.Debug("placement: no capacity for %s in %s, trying next candidate: %v",
+			c.InstanceType, c.LocationCode, err)
+		errs = append(errs, fmt.Errorf("%s in %s: %w", c.InstanceType, c.LocationCode, err))
+	}
+
+	return result, dcerr.NewBatchError(ErrCodeCapacityUnavailable,
+		fmt.Sprintf("none of the %d candidates could be launched", len(candidates)), errs)
+}
+
+// candidateContract returns the contract to launch a candidate with, given
+// the contract of the input.
+func candidateContract(contract instance.ContractType, spot bool) instance.ContractType {
+	switch {
+	case spot:
+		return instance.ContractSpot
+	case contract == instance.ContractSpot:
+		return instance.ContractPayAsYouGo
+	default:
+		return contract
+	}
+}
+
+// candidateKey returns the suffix identifying a candidate in derived
+// idempotency keys.
+func candidateKey(c *Candidate) string {
+	key := c.InstanceType + "-" + c.LocationCode
+	if c.Spot {
+		key += "-spot"
+	}
+	return key
+}
@@ -0,0 +1,197 @@
+package placement
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instance"
+)
+
+type fakeCreator struct {
+	errs     map[string]error
+	attempts []instance.CreateInstanceInput
+	keys     []string
+}
+
+func (f *fakeCreator) CreateInstanceWithContext(_ context.Context, input *instance.CreateInstanceInput, opts ...request.Option) (string, error) {
+	f.attempts = append(f.attempts, *input)
+
+	req := &request.Request{HTTPRequest: &http.Request{Header: http.Header{}}}
+	req.ApplyOptions(opts...)
+	f.keys = append(f.keys, req.IdempotencyKey())
+
+	if err := f.errs[input.InstanceType+"@"+input.LocationCode]; err != nil {
+		return "", err
+	}
+	return "instance-" + input.LocationCode, nil
+}
+
+var noCapacityErr = dcerr.NewHTTPError(http.StatusBadRequest,
+	`{"code":"invalid_request","message":"Not enough resources available for this instance type"}`, nil)
+
+func TestLaunch_FallsBackOnCapacityErrors(t *testing.T) {
+	creator := &fakeCreator{errs: map[string]error{"1H100@FIN-01": noCapacityErr}}
+	candidates := []*Candidate{
+		{InstanceType: "1H100", LocationCode: "FIN-01"},
+		{InstanceType: "1H100", LocationCode: "ICE-01", Spot: true},
+	}
+
+	input := &instance.CreateInstanceInput{Image: "ubuntu-22.04", Hostname: "trainer"}
+	result, err := Launch(context.Background(), creator, input, candidates)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Candidate != candidates[1] || result.InstanceID != "instance-ICE-01" {
+		t.Errorf("expected second candidate to be launched, got %+v", result)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Err != noCapacityErr {
+		t.Errorf("expected the first candidate's failure to be reported, got %+v", result.Failures)
+	}
+	if !creator.attempts[1].IsSpot || creator.attempts[1].Hostname != "trainer" {
+		t.Errorf("expected candidate settings on top of the input, got %+v", creator.attempts[1])
+	}
+	if input.LocationCode != "" {
+		t.Error("expected input not to be modified")
+	}
+}
+
+func TestLaunch_StopsOnOtherErrors(t *testing.T) {
+	fundsErr := dcerr.NewHTTPError(http.StatusPaymentRequired,
+		`{"code":"insufficient_funds","message":"Insufficient funds"}`, nil)
+	creator := &fakeCreator{errs: map[string]error{"1H100@FIN-01": fundsErr}}
+
+	result, err := Launch(context.Background(), creator, &instance.CreateInstanceInput{}, []*Candidate{
+		{InstanceType: "1H100", LocationCode: "FIN-01"},
+		{InstanceType: "1H100", LocationCode: "ICE-01"},
+	})
+	if err != fundsErr {
+		t.Fatalf("expected insufficient funds error, got %v", err)
+	}
+	if len(creator.attempts) != 1 || result.Candidate != nil {
+		t.Errorf("expected a single attempt, got %d", len(creator.attempts))
+	}
+}
+
+func TestLaunch_AllCandidatesWithoutCapacity(t *testing.T) {
+	creator := &fakeCreator{errs: map[string]error{
+		"1H100@FIN-01": noCapacityErr,
+		"1H100@ICE-01": dcerr.NewHTTPError(http.StatusServiceUnavailable,
+			`{"code":"insufficient_capacity","message":"No capacity"}`, nil),
+	}}
+
+	result, err := Launch(context.Background(), creator, &instance.CreateInstanceInput{}, []*Candidate{
+		{InstanceType: "1H100", LocationCode: "FIN-01"},
+		{InstanceType: "1H100", LocationCode: "ICE-01"},
+	})
+	batchErr, ok := err.(dcerr.BatchedErrors)
+	if !ok || batchErr.Code() != ErrCodeCapacityUnavailable {
+		t.Fatalf("expected %s error, got %v", ErrCodeCapacityUnavailable, err)
+	}
+	if len(batchErr.OrigErrs()) != 2 || len(result.Failures) != 2 {
+		t.Errorf("expected both failures to be reported, got %v", batchErr.OrigErrs())
+	}
+}
+
+func TestIsCapacityError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "capacity message", err: noCapacityErr, expected: true},
+		{name: "capacity message with period and case",
+			err: dcerr.NewHTTPError(http.StatusBadRequest, `{"code":"invalid_request","message":"not enough resources available."}`, nil), expected: true},
+		{name: "capacity code",
+			err: dcerr.NewHTTPError(http.StatusConflict, `{"code":"insufficient_capacity","message":"Try again later"}`, nil), expected: true},
+		{name: "capacity code on service unavailable",
+			err: dcerr.NewHTTPError(http.StatusServiceUnavailable, `{"code":"insufficient_capacity","message":"No capacity"}`, nil), expected: true},
+		{name: "service unavailable", err: dcerr.NewHTTPError(http.StatusServiceUnavailable, "", nil)},
+		{name: "service unavailable code",
+			err: dcerr.NewHTTPError(http.StatusServiceUnavailable, `{"code":"service_unavailable","message":"Maintenance"}`, nil)},
+		{name: "capacity message on service unavailable",
+			err: dcerr.NewHTTPError(http.StatusServiceUnavailable, `{"code":"service_unavailable","message":"Insufficient capacity"}`, nil)},
+		{name: "image not available",
+			err: dcerr.NewHTTPError(http.StatusBadRequest, `{"code":"invalid_request","message":"Image ubuntu-18.04 is not available"}`, nil)},
+		{name: "location unavailable",
+			err: dcerr.NewHTTPError(http.StatusBadRequest, `{"code":"invalid_request","message":"Location FIN-03 is unavailable"}`, nil)},
+		{name: "insufficient funds",
+			err: dcerr.NewHTTPError(http.StatusPaymentRequired, `{"code":"insufficient_funds","message":"Insufficient funds"}`, nil)},
+		{name: "server error", err: dcerr.NewHTTPError(http.StatusInternalServerError, `{"code":"server_error","message":"Out of stock"}`, nil)},
+		{name: "not an HTTP error", err: dcerr.New(request.ErrCodeInvalidParameter, "Out of stock", nil)},
+		{name: "nil", err: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsCapacityError(tt.err); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestLaunch_IdempotencyKeyPerCandidate(t *testing.T) {
+	creator := &fakeCreator{errs: map[string]error{"1H100@FIN-01": noCapacityErr}}
+	candidates := []*Candidate{
+		{InstanceType: "1H100", LocationCode: "FIN-01", Spot: true},
+		{InstanceType: "1H100", LocationCode: "FIN-01"},
+		{InstanceType: "1H100", LocationCode: "ICE-01"},
+	}
+
+	_, err := Launch(context.Background(), creator, &instance.CreateInstanceInput{}, candidates,
+		request.WithIdempotencyKey("trainer-1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []string{"trainer-1-1H100-FIN-01-spot", "trainer-1-1H100-FIN-01", "trainer-1-1H100-ICE-01"}
+	if !reflect.DeepEqual(creator.keys, expected) {
+		t.Errorf("expected idempotency keys %q, got %q", expected, creator.keys)
+	}
+
+	// Without a caller key nothing is derived, the SDK generates one per request
+	creator = &fakeCreator{errs: map[string]error{"1H100@FIN-01": noCapacityErr}}
+	if _, err := Launch(context.Background(), creator, &instance.CreateInstanceInput{}, candidates); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creator.keys[0] != "" || creator.keys[1] != "" || creator.keys[2] != "" {
+		t.Errorf("expected no caller keys, got %q", creator.keys)
+	}
+}
+
+func TestLaunch_ContractPerCandidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		contract instance.ContractType
+		spot     bool
+		expected instance.ContractType
+	}{
+		{name: "spot candidate", contract: instance.ContractPayAsYouGo, spot: true, expected: instance.ContractSpot},
+		{name: "spot candidate with long term input", contract: instance.ContractLongTerm, spot: true, expected: instance.ContractSpot},
+		{name: "on-demand candidate with spot input", contract: instance.ContractSpot, expected: instance.ContractPayAsYouGo},
+		{name: "on-demand candidate", contract: instance.ContractLongTerm, expected: instance.ContractLongTerm},
+		{name: "on-demand candidate without contract", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			creator := &fakeCreator{}
+			input := &instance.CreateInstanceInput{Contract: tt.contract}
+			_, err := Launch(context.Background(), creator, input, []*Candidate{
+				{InstanceType: "1H100", LocationCode: "FIN-01", Spot: tt.spot},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := creator.attempts[0]; got.Contract != tt.expected || got.IsSpot != tt.spot {
+				t.Errorf("expected contract %q and spot %v, got %q and %v", tt.expected, tt.spot, got.Contract, got.IsSpot)
+			}
+			if input.Contract != tt.contract {
+				t.Error("expected input not to be modified")
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+// Package placement finds where an instance can be launched right now. It
+// joins instance availability, instance type pricing and locations into a
+// list of candidates ranked by price.
+package placement
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/client"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instanceavailability"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instancetypes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/locations"
+)
+
+// InstanceTypesAPI lists instance types and their prices. It is satisfied by
+// the instancetypes client.
+type InstanceTypesAPI interface {
+	ListInstanceTypesWithContext(ctx context.Context, opts ...request.Option) ([]*instancetypes.InstanceTypeResponse, error)
+}
+
+// AvailabilityAPI reports which instance types can be launched where. It is
+// satisfied by the instanceavailability client.
+type AvailabilityAPI interface {
+	ListInstanceAvailabilityWithContext(ctx context.Context, opts ...request.Option) ([]*instanceavailability.InstanceAvailabilityResponse, error)
+	CheckInstanceAvailabilityWithContext(ctx context.Context, input *instanceavailability.CheckInstanceAvailabilityInput, opts ...request.Option) (bool, error)
+}
+
+// LocationsAPI lists locations. It is satisfied by the locations client.
+type LocationsAPI interface {
+	ListLocationsWithContext(ctx context.Context, opts ...request.Option) ([]*locations.LocationResponse, error)
+}
+
+// Requirements describe the instances a caller is willing to launch.
+type Requirements struct {
+	// GPUModel is matched case-insensitively against the model and GPU
+	// description of instance types, e.g. "A100" or "H100 SXM5". Empty
+	// matches every instance type.
+	GPUModel string
+
+	// GPUCount is the exact number of GPUs required. Zero matches any count.
+	GPUCount int
+
+	// Spot selects spot instances and spot prices instead of on-demand ones.
+	Spot bool
+
+	// Locations restricts candidates to the given location codes. Empty
+	// allows every location.
+	Locations []string
+
+	// MaxPricePerHour excludes candidates costing more per hour. Zero means
+	// no limit.
+	MaxPricePerHour float64
+}
+
+// Candidate is an instance type that can currently be launched in a
+// location.
+type Candidate struct {
+	InstanceType string
+	LocationCode string
+	LocationName string
+	PricePerHour float64
+	Currency     string
+	Spot         bool
+	GPUModel     string
+	GPUCount     int
+}
+
+// DefaultMaxConcurrency is the number of spot availability checks performed
+// at once by a Placer when MaxConcurrency is not set.
+const DefaultMaxConcurrency = 8
+
+// Placer ranks launch candidates using the instance types, availability and
+// locations APIs.
+type Placer struct {
+	InstanceTypes InstanceTypesAPI
+	Availability  AvailabilityAPI
+	Locations     LocationsAPI
+
+	// MaxConcurrency bounds the number of spot availability checks in flight
+	// at once. If zero, DefaultMaxConcurrency is used.
+	MaxConcurrency int
+}
+
+// New returns a Placer using service clients created from the config
+// provider, typically a session.
+func New(p client.ConfigProvider) *Placer {
+	return &Placer{
+		InstanceTypes: instancetypes.New(p),
+		Availability:  instanceavailability.New(p),
+		Locations:     locations.New(p),
+	}
+}
+
+// Candidates returns the instance types matching the requirements that are
+// currently available, one per location, ranked from cheapest to most
+// expensive. Ties are ordered by instance type and location code.
+func (p *Placer) Candidates(ctx context.Context, req *Requirements) ([]*Candidate, error) {
+	if req == nil {
+		req = &Requirements{}
+	}
+
+	locs, err := p.Locations.ListLocationsWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	locationNames := make(map[string]string, len(locs))
+	for _, l := range locs {
+		if l != nil {
+			locationNames[l.Code] = l.Name
+		}
+	}
+	for _, code := range req.Locations {
+		if _, ok := locationNames[code]; !ok {
+			return nil, dcerr.New(request.ErrCodeInvalidParameter,
+				fmt.Sprintf("unknown location %q", code), nil)
+		}
+	}
+
+	types, err := p.InstanceTypes.ListInstanceTypesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Instance types listed as available, by location
+	available := map[string]map[string]bool{}
+	if !req.Spot {
+		availability, err := p.Availability.ListInstanceAvailabilityWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range availability {
+			if a == nil {
+				continue
+			}
+			if available[a.LocationCode] == nil {
+				available[a.LocationCode] = map[string]bool{}
+			}
+			for _, t := range a.Availabilities {
+				available[a.LocationCode][t] = true
+			}
+		}
+	}
+
+	var candidates []*Candidate
+	for _, t := range types {
+		if t == nil || !matchesGPU(t, req) {
+			continue
+		}
+
+		price, ok := instanceTypePrice(t, req.Spot)
+		if !ok || (req.MaxPricePerHour > 0 && price > req.MaxPricePerHour) {
+			continue
+		}
+
+		for _, code := range allowedLocations(req, locs) {
+			if !req.Spot && !available[code][t.InstanceType] {
+				continue
+			}
+			candidates = append(candidates, &Candidate{
+				InstanceType: t.InstanceType,
+				LocationCode: code,
+				LocationName: locationNames[code],
+				PricePerHour: price,
+				Currency:     t.Currency,
+				Spot:         req.Spot,
+				GPUModel:     t.Model,
+				GPUCount:     gpuCount(t),
+			})
+		}
+	}
+
+	if req.Spot {
+		// Spot capacity is not part of the availability list and is checked
+		// per instance type and location instead
+		candidates, err = p.spotAvailable(ctx, candidates)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		a, b := candidates[i], candidates[j]
+		if a.PricePerHour != b.PricePerHour {
+			return a.PricePerHour < b.PricePerHour
+		}
+		if a.InstanceType != b.InstanceType {
+			return a.InstanceType < b.InstanceType
+		}
+		return a.LocationCode < b.LocationCode
+	})
+
+	return candidates, nil
+}
+
+// Cheapest returns the cheapest available candidate, or nil if none matches
+// the requirements.
+func (p *Placer) Cheapest(ctx context.Context, req *Requirements) (*Candidate, error) {
+	candidates, err := p.Candidates(ctx, req)
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+	return candidates[0], nil
+}
+
+// spotAvailable returns the candidates with spot capacity, checking up to
+// MaxConcurrency of them at once. The first failed check cancels the others
+// and is returned.
+func (p *Placer) spotAvailable(ctx context.Context, candidates []*Candidate) ([]*Candidate, error) {
+	concurrency := p.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultMaxConcurrency
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		ok       = make([]bool, len(candidates))
+		sem      = make(chan struct{}, concurrency)
+	)
+
+	for i, c := range candidates {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func(i int, c *Candidate) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			isSpot, location := true, c.LocationCode
+			available, err := p.Availability.CheckInstanceAvailabilityWithContext(ctx,
+				&instanceavailability.CheckInstanceAvailabilityInput{
+					InstanceType: c.InstanceType,
+					LocationCode: &location,
+					IsSpot:       &isSpot,
+				})
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			ok[i] = available
+		}(i, c)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	// The caller's context was canceled before every check was started
+	if err := ctx.Err(); err != nil {
+		return nil, dcerr.New(request.CanceledErrorCode, "request context canceled", err)
+	}
+
+	available := candidates[:0]
+	for i, c := range candidates {
+		if ok[i] {
+			available = append(available, c)
+		}
+	}
+	return available, nil
+}
+
+// matchesGPU returns true if the instance type has the required GPU model
+// and count.
+func matchesGPU(t *instancetypes.InstanceTypeResponse, req *Requirements) bool {
+	if req.GPUCount > 0 && gpuCount(t) != req.GPUCount {
+		return false
+	}
+	if req.GPUModel == "" {
+		return true
+	}
+	model := strings.ToLower(req.GPUModel)
+	return strings.Contains(strings.ToLower(t.Model), model) ||
+		strings.Contains(strings.ToLower(t.GPU.Description), model)
+}
+
+// gpuCount returns the number of GPUs of the instance type.
+func gpuCount(t *instancetypes.InstanceTypeResponse) int {
+	if t.GPU.NumberOfGPUs == nil {
+		return 0
+	}
+	return int(*t.GPU.NumberOfGPUs)
+}
+
+// instanceTypePrice returns the hourly spot or on-demand price of the
+// instance type. False is returned if the price is missing or invalid.
+func instanceTypePrice(t *instancetypes.InstanceTypeResponse, spot bool) (float64, bool) {
+	raw := t.PricePerHour
+	if spot {
+		raw = t.SpotPrice
+	}
+	price, err := strconv.ParseFloat(raw, 64)
+	if err != nil || price <= 0 {
+		logger.Debug("placement: skipping instance type %s with invalid price %q", t.InstanceType, raw)
+		return 0, false
+	}
+	return price, true
+}
+
+// allowedLocations returns the location codes candidates may be placed in.
+func allowedLocations(req *Requirements, locs []*locations.LocationResponse) []string {
+	if len(req.Locations) > 0 {
+		return req.Locations
+	}
+	codes := make([]string, 0, len(locs))
+	for _, l := range locs {
+		if l != nil {
+			codes = append(codes, l.Code)
+		}
+	}
+	return codes
+}
@@ -0,0 +1,204 @@
+package placement
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instanceavailability"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instancetypes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/locations"
+)
+
+type fakeCatalog struct {
+	types        []*instancetypes.InstanceTypeResponse
+	availability []*instanceavailability.InstanceAvailabilityResponse
+	spot         map[string]bool
+	locations    []*locations.LocationResponse
+}
+
+func (f *fakeCatalog) ListInstanceTypesWithContext(context.Context, ...request.Option) ([]*instancetypes.InstanceTypeResponse, error) {
+	return f.types, nil
+}
+
+func (f *fakeCatalog) ListInstanceAvailabilityWithContext(context.Context, ...request.Option) ([]*instanceavailability.InstanceAvailabilityResponse, error) {
+	return f.availability, nil
+}
+
+func (f *fakeCatalog) CheckInstanceAvailabilityWithContext(_ context.Context, input *instanceavailability.CheckInstanceAvailabilityInput, _ ...request.Option) (bool, error) {
+	return f.spot[input.InstanceType+"@"+*input.LocationCode], nil
+}
+
+func (f *fakeCatalog) ListLocationsWithContext(context.Context, ...request.Option) ([]*locations.LocationResponse, error) {
+	return f.locations, nil
+}
+
+func gpuType(name, model string, gpus int64, price, spotPrice string) *instancetypes.InstanceTypeResponse {
+	return &instancetypes.InstanceTypeResponse{
+		InstanceType: name,
+		Model:        model,
+		GPU:          instancetypes.GPU{Description: "1x " + model, NumberOfGPUs: &gpus},
+		PricePerHour: price,
+		SpotPrice:    spotPrice,
+		Currency:     "usd",
+	}
+}
+
+func newTestPlacer() *Placer {
+	catalog := &fakeCatalog{
+		types: []*instancetypes.InstanceTypeResponse{
+			gpuType("8H100.80S.176V", "H100", 8, "19.92", "6.96"),
+			gpuType("1H100.80S.22V", "H100", 1, "2.49", "0.87"),
+			gpuType("1A100.22V", "A100", 1, "1.29", "0.45"),
+			gpuType("1A100.40S.22V", "A100", 1, "1.10", "0.40"),
+		},
+		availability: []*instanceavailability.InstanceAvailabilityResponse{
+			{LocationCode: "FIN-01", Availabilities: []string{"1A100.22V", "1H100.80S.22V"}},
+			{LocationCode: "ICE-01", Availabilities: []string{"1A100.22V", "8H100.80S.176V"}},
+		},
+		spot: map[string]bool{
+			"1A100.40S.22V@ICE-01": true,
+			"1A100.22V@FIN-01":     true,
+		},
+		locations: []*locations.LocationResponse{
+			{Code: "FIN-01", Name: "Finland 1"},
+			{Code: "ICE-01", Name: "Iceland 1"},
+		},
+	}
+	return &Placer{InstanceTypes: catalog, Availability: catalog, Locations: catalog}
+}
+
+func TestCandidates_RankedByPrice(t *testing.T) {
+	candidates, err := newTestPlacer().Candidates(context.Background(), &Requirements{GPUModel: "a100"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 1A100.40S.22V is cheaper but not available on demand
+	want := []string{"1A100.22V@FIN-01", "1A100.22V@ICE-01"}
+	if len(candidates) != len(want) {
+		t.Fatalf("expected %d candidates, got %d", len(want), len(candidates))
+	}
+	for i, c := range candidates {
+		if got := c.InstanceType + "@" + c.LocationCode; got != want[i] {
+			t.Errorf("candidate %d: expected %s, got %s", i, want[i], got)
+		}
+	}
+	if candidates[0].PricePerHour != 1.29 || candidates[0].LocationName != "Finland 1" {
+		t.Errorf("unexpected candidate %+v", candidates[0])
+	}
+}
+
+func TestCandidates_Filters(t *testing.T) {
+	p := newTestPlacer()
+
+	candidates, err := p.Candidates(context.Background(), &Requirements{
+		GPUModel:  "H100",
+		GPUCount:  8,
+		Locations: []string{"ICE-01"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(candidates) != 1 || candidates[0].InstanceType != "8H100.80S.176V" {
+		t.Errorf("expected the 8x H100 in ICE-01, got %v", candidates)
+	}
+
+	candidates, err = p.Candidates(context.Background(), &Requirements{MaxPricePerHour: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, c := range candidates {
+		if c.PricePerHour > 2 {
+			t.Errorf("candidate %s exceeds max price: %v", c.InstanceType, c.PricePerHour)
+		}
+	}
+
+	if _, err := p.Candidates(context.Background(), &Requirements{Locations: []string{"MARS-01"}}); err == nil {
+		t.Error("expected error for unknown location")
+	}
+}
+
+func TestCheapest_Spot(t *testing.T) {
+	c, err := newTestPlacer().Cheapest(context.Background(), &Requirements{GPUModel: "A100", Spot: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c == nil || c.InstanceType != "1A100.40S.22V" || c.LocationCode != "ICE-01" || c.PricePerHour != 0.40 || !c.Spot {
+		t.Errorf("unexpected cheapest spot candidate %+v", c)
+	}
+}
+
+// slowAvailability records how many spot checks are in flight at once.
+type slowAvailability struct {
+	*fakeCatalog
+	err         error
+	calls       int32
+	inFlight    int32
+	maxInFlight int32
+}
+
+func (s *slowAvailability) CheckInstanceAvailabilityWithContext(ctx context.Context, input *instanceavailability.CheckInstanceAvailabilityInput, opts ...request.Option) (bool, error) {
+	atomic.AddInt32(&s.calls, 1)
+	n := atomic.AddInt32(&s.inFlight, 1)
+	defer atomic.AddInt32(&s.inFlight, -1)
+	for {
+		max := atomic.LoadInt32(&s.maxInFlight)
+		if n <= max || atomic.CompareAndSwapInt32(&s.maxInFlight, max, n) {
+			break
+		}
+	}
+
+	select {
+	case <-time.After(10 * time.Millisecond):
+	case <-ctx.Done():
+		return false, ctx.Err()
+	}
+	if s.err != nil {
+		return false, s.err
+	}
+	return s.fakeCatalog.CheckInstanceAvailabilityWithContext(ctx, input, opts...)
+}
+
+func TestCandidates_SpotChecksBounded(t *testing.T) {
+	p := newTestPlacer()
+	availability := &slowAvailability{fakeCatalog: p.Availability.(*fakeCatalog)}
+	p.Availability = availability
+	p.MaxConcurrency = 3
+
+	candidates, err := p.Candidates(context.Background(), &Requirements{Spot: true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 4 instance types in 2 locations
+	if got := atomic.LoadInt32(&availability.calls); got != 8 {
+		t.Errorf("expected 8 spot checks, got %d", got)
+	}
+	if got := atomic.LoadInt32(&availability.maxInFlight); got > 3 || got < 2 {
+		t.Errorf("expected 2 to 3 spot checks in flight at once, got %d", got)
+	}
+
+	var got []string
+	for _, c := range candidates {
+		got = append(got, c.InstanceType+"@"+c.LocationCode)
+	}
+	expected := []string{"1A100.40S.22V@ICE-01", "1A100.22V@FIN-01"}
+	if len(got) != len(expected) || got[0] != expected[0] || got[1] != expected[1] {
+		t.Errorf("expected spot candidates %v, got %v", expected, got)
+	}
+}
+
+func TestCandidates_SpotCheckError(t *testing.T) {
+	checkErr := errors.New("availability unavailable")
+	p := newTestPlacer()
+	p.Availability = &slowAvailability{fakeCatalog: p.Availability.(*fakeCatalog), err: checkErr}
+	p.MaxConcurrency = 2
+
+	if _, err := p.Candidates(context.Background(), &Requirements{Spot: true}); err != checkErr {
+		t.Fatalf("expected the spot check error, got %v", err)
+	}
+}
@@ -0,0 +1,174 @@
+// Package ratelimit provides client-side request rate limiting and a retry
+// quota that can be shared by every service client created from a session.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultRetryQuotaCapacity is the default number of tokens available to
+	// a RetryQuota.
+	DefaultRetryQuotaCapacity = 500
+
+	// DefaultRetryCost is the number of tokens taken from the retry quota for
+	// each retry attempt.
+	DefaultRetryCost = 5
+
+	// DefaultNoRetryIncrement is the number of tokens returned to the retry
+	// quota when a request succeeds without being retried.
+	DefaultNoRetryIncrement = 1
+)
+
+// Limiter is a token bucket rate limiter that allows requests at a steady
+// rate with bursts of up to a fixed size. A nil Limiter never blocks.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter that allows requestsPerSecond requests per
+// second on average and bursts of up to burst requests. A burst lower than
+// one is treated as one.
+func NewLimiter(requestsPerSecond float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &Limiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait blocks until a request may be sent. It returns the context's error if
+// the context is canceled, or context.DeadlineExceeded without waiting if the
+// context's deadline would expire first.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil || l.rate <= 0 {
+		return nil
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := l.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+		l.cancel()
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		l.cancel()
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller has
+// to wait before the token becomes available.
+func (l *Limiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+// cancel returns a reserved token that was never used.
+func (l *Limiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens++
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// RetryQuota is a token bucket limiting the number of retries that may be
+// made. Retries take tokens from the quota, while successful requests return
+// them, so that retries stop once most requests are failing. A nil RetryQuota
+// never limits retries.
+type RetryQuota struct {
+	mu        sync.Mutex
+	capacity  int
+	available int
+}
+
+// NewRetryQuota returns a RetryQuota holding capacity tokens.
+func NewRetryQuota(capacity int) *RetryQuota {
+	return &RetryQuota{
+		capacity:  capacity,
+		available: capacity,
+	}
+}
+
+// Acquire takes cost tokens from the quota, returning false if not enough
+// tokens are available.
+func (q *RetryQuota) Acquire(cost int) bool {
+	if q == nil {
+		return true
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if cost > q.available {
+		return false
+	}
+	q.available -= cost
+	return true
+}
+
+// Release returns amount tokens to the quota, up to its capacity.
+func (q *RetryQuota) Release(amount int) {
+	if q == nil {
+		return
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.available += amount
+	if q.available > q.capacity {
+		q.available = q.capacity
+	}
+}
+
+// Available returns the number of tokens currently available.
+func (q *RetryQuota) Available() int {
+	if q == nil {
+		return 0
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return q.available
+}
@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestLimiter_AllowsBurstThenWaits(t *testing.T) {
+	l := NewLimiter(20, 3)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Errorf("expected burst to be allowed immediately, took %v", elapsed)
+	}
+
+	start = time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("expected request beyond burst to wait ~50ms, took %v", elapsed)
+	}
+}
+
+func TestLimiter_WaitHonorsContext(t *testing.T) {
+	l := NewLimiter(1, 1)
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := l.Wait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("expected Wait to return without waiting, took %v", elapsed)
+	}
+}
+
+func TestLimiter_NilNeverBlocks(t *testing.T) {
+	var l *Limiter
+	if err := l.Wait(context.Background()); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRetryQuota(t *testing.T) {
+	q := NewRetryQuota(10)
+
+	if !q.Acquire(DefaultRetryCost) || !q.Acquire(DefaultRetryCost) {
+		t.Fatal("expected quota to allow two retries")
+	}
+	if q.Acquire(DefaultRetryCost) {
+		t.Error("expected exhausted quota to deny retry")
+	}
+
+	q.Release(DefaultRetryCost)
+	if got := q.Available(); got != DefaultRetryCost {
+		t.Errorf("expected %d tokens, got %d", DefaultRetryCost, got)
+	}
+
+	q.Release(100)
+	if got := q.Available(); got != 10 {
+		t.Errorf("expected release to be capped at capacity, got %d", got)
+	}
+}
@@ -0,0 +1,131 @@
+package request
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+)
+
+// ErrorClass is the retry classification of a request error.
+type ErrorClass int
+
+const (
+	// ErrorClassUnknown means the registry has no entry for the error.
+	ErrorClassUnknown ErrorClass = iota
+
+	// ErrorClassNonRetryable errors are never retried.
+	ErrorClassNonRetryable
+
+	// ErrorClassRetryable errors are retried with the regular backoff delay.
+	ErrorClassRetryable
+
+	// ErrorClassThrottle errors are retried with the throttle backoff delay.
+	ErrorClassThrottle
+
+	// ErrorClassExpiredCredentials errors signal that the request credentials
+	// must be refreshed. They are handled by re-authenticating the request
+	// rather than by the Retryer.
+	ErrorClassExpiredCredentials
+)
+
+// ErrorRegistry maps DataCrunch API error codes, SDK error codes and HTTP
+// status codes to an ErrorClass. API and SDK error codes take precedence over
+// HTTP status codes. It is safe for concurrent use.
+type ErrorRegistry struct {
+	mu          sync.RWMutex
+	codes       map[string]ErrorClass
+	statusCodes map[int]ErrorClass
+}
+
+// DefaultErrorRegistry is the registry consulted by the SDK's retry logic.
+// Entries may be added or overridden to tune which errors are retried.
+var DefaultErrorRegistry = newDefaultErrorRegistry()
+
+// NewErrorRegistry returns an empty ErrorRegistry.
+func NewErrorRegistry() *ErrorRegistry {
+	return &ErrorRegistry{
+		codes:       map[string]ErrorClass{},
+		statusCodes: map[int]ErrorClass{},
+	}
+}
+
+func newDefaultErrorRegistry() *ErrorRegistry {
+	e := NewErrorRegistry()
+
+	// SDK error codes
+	e.RegisterCode(ErrCodeRequestError, ErrorClassRetryable)
+	e.RegisterCode(ErrCodeResponseTimeout, ErrorClassRetryable)
+	e.RegisterCode("RequestTimeout", ErrorClassRetryable)
+	e.RegisterCode(CanceledErrorCode, ErrorClassNonRetryable)
+	e.RegisterCode(ErrCodeReauthenticationFailed, ErrorClassNonRetryable)
+
+	// DataCrunch API error codes
+	e.RegisterCode(dcerr.APIErrCodeInvalidRequest, ErrorClassNonRetryable)
+	e.RegisterCode(dcerr.APIErrCodeUnauthorizedRequest, ErrorClassExpiredCredentials)
+	e.RegisterCode(dcerr.APIErrCodeInsufficientFunds, ErrorClassNonRetryable)
+	e.RegisterCode(dcerr.APIErrCodeForbiddenAction, ErrorClassNonRetryable)
+	e.RegisterCode(dcerr.APIErrCodeNotFound, ErrorClassNonRetryable)
+	e.RegisterCode(dcerr.APIErrCodeServerError, ErrorClassRetryable)
+	e.RegisterCode(dcerr.APIErrCodeServiceUnavailable, ErrorClassThrottle)
+	e.RegisterCode(dcerr.APIErrCodeRateLimitExceeded, ErrorClassThrottle)
+
+	// HTTP status codes
+	e.RegisterStatusCode(http.StatusUnauthorized, ErrorClassExpiredCredentials)
+	e.RegisterStatusCode(http.StatusTooManyRequests, ErrorClassThrottle)
+	e.RegisterStatusCode(http.StatusInternalServerError, ErrorClassRetryable)
+	// 501 Not Implemented means the server cannot handle the request method
+	e.RegisterStatusCode(http.StatusNotImplemented, ErrorClassNonRetryable)
+	e.RegisterStatusCode(http.StatusBadGateway, ErrorClassThrottle)
+	e.RegisterStatusCode(http.StatusServiceUnavailable, ErrorClassThrottle)
+	e.RegisterStatusCode(http.StatusGatewayTimeout, ErrorClassThrottle)
+
+	return e
+}
+
+// RegisterCode sets the class of an API or SDK error code.
+func (e *ErrorRegistry) RegisterCode(code string, class ErrorClass) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.codes[code] = class
+}
+
+// RegisterStatusCode sets the class of an HTTP status code.
+func (e *ErrorRegistry) RegisterStatusCode(statusCode int, class ErrorClass) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.statusCodes[statusCode] = class
+}
+
+// CodeClass returns the class registered for an API or SDK error code.
+func (e *ErrorRegistry) CodeClass(code string) ErrorClass {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.codes[code]
+}
+
+// StatusCodeClass returns the class registered for an HTTP status code.
+func (e *ErrorRegistry) StatusCodeClass(statusCode int) ErrorClass {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.statusCodes[statusCode]
+}
+
+// Classify returns the class of err. A dcerr.HTTPError is classified by its
+// API error code, falling back to its HTTP status code. A dcerr.Error is
+// classified by its code.
+func (e *ErrorRegistry) Classify(err error) ErrorClass {
+	switch err := err.(type) {
+	case *dcerr.HTTPError:
+		if err.ErrorResponse != nil && err.ErrorResponse.Code != "" {
+			if class := e.CodeClass(err.ErrorResponse.Code); class != ErrorClassUnknown {
+				return class
+			}
+		}
+		return e.StatusCodeClass(err.StatusCode)
+	case dcerr.Error:
+		return e.CodeClass(err.Code())
+	default:
+		return ErrorClassUnknown
+	}
+}
@@ -136,6 +136,7 @@ func (l *HandlerList) Run(r *Request) {
 type Handlers struct {
 	Validate  HandlerList // Validate parameters
 	Build     HandlerList // Build requests
+	Sign      HandlerList // Sign requests, run before every attempt
 	Unmarshal HandlerList // Unmarshal response
 	Complete  HandlerList // Complete Request
 }
@@ -144,6 +145,7 @@ func (h *Handlers) Copy() Handlers {
 	return Handlers{
 		Validate:  h.Validate.copy(),
 		Build:     h.Build.copy(),
+		Sign:      h.Sign.copy(),
 		Unmarshal: h.Unmarshal.copy(),
 		Complete:  h.Complete.copy(),
 	}
@@ -152,6 +154,7 @@ func (h *Handlers) Copy() Handlers {
 func (h *Handlers) Clear() {
 	h.Validate.Clear()
 	h.Build.Clear()
+	h.Sign.Clear()
 	h.Unmarshal.Clear()
 	h.Complete.Clear()
 }
@@ -163,6 +166,9 @@ func (h *Handlers) IsEmpty() bool {
 	if h.Build.Len() != 0 {
 		return false
 	}
+	if h.Sign.Len() != 0 {
+		return false
+	}
 	if h.Unmarshal.Len() != 0 {
 		return false
 	}
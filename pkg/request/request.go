@@ -10,8 +10,10 @@ import (
 	"strings"
 	"time"
 
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/config"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/ratelimit"
 )
 
 const (
@@ -34,8 +36,21 @@ const (
 	// ErrCodeRequestError is an error preventing the SDK from continuing to
 	// process the request.
 	ErrCodeRequestError = "RequestError"
+
+	// ErrCodeReauthenticationFailed is the error code returned when a request
+	// rejected with HTTP 401 could not be signed again with fresh credentials.
+	ErrCodeReauthenticationFailed = "ReauthenticationFailed"
+
+	// ErrCodeInvalidParameter is the error code returned when an operation is
+	// given invalid input.
+	ErrCodeInvalidParameter = "InvalidParameter"
 )
 
+// IdempotencyKeyHeader is the HTTP header carrying the idempotency key of a
+// mutating request. The same key is sent with every retry of a request so the
+// API can recognize replays of an operation it already performed.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
 // Request is a simplified version focusing only on the essential functionality
 // for making HTTP requests with retry support.
 type Request struct {
@@ -55,8 +70,22 @@ type Request struct {
 	RetryDelay   time.Duration
 	Time         time.Time
 
-	context context.Context
-	built   bool
+	// RetryAfter is the delay requested by the service through the
+	// Retry-After response header, if the retryer honored it for the
+	// current RetryDelay.
+	RetryAfter time.Duration
+
+	// Reauthenticating is true while the request is signed again after an
+	// HTTP 401 response. Sign handlers should discard any cached credentials
+	// the rejected attempt was signed with.
+	Reauthenticating bool
+
+	context            context.Context
+	built              bool
+	reauthenticated    bool
+	retryQuotaAcquired int
+
+	idempotencyKeyGenerated bool
 
 	// Additional API error codes that should be retried.
 	RetryErrorCodes []string
@@ -155,6 +184,57 @@ func WithGetResponseHeaders(headers *http.Header) Option {
 	}
 }
 
+// WithIdempotencyKey builds a request Option which sets the idempotency key
+// sent with the request, instead of one generated by the SDK. Callers can use
+// it to make an operation safe to repeat across separate calls.
+func WithIdempotencyKey(key string) Option {
+	return func(r *Request) {
+		r.HTTPRequest.Header.Set(IdempotencyKeyHeader, key)
+		r.idempotencyKeyGenerated = false
+	}
+}
+
+// WithDerivedIdempotencyKey builds a request Option which appends suffix to
+// the idempotency key given with WithIdempotencyKey. Operations sending one
+// request per item use it, after the caller's options, so that every request
+// gets its own key while repeating the whole operation with the same key
+// stays safe. It has no effect on requests without a caller-supplied key.
+func WithDerivedIdempotencyKey(suffix string) Option {
+	return func(r *Request) {
+		key := r.IdempotencyKey()
+		if key == "" || r.idempotencyKeyGenerated {
+			return
+		}
+		r.HTTPRequest.Header.Set(IdempotencyKeyHeader, key+"-"+suffix)
+	}
+}
+
+// IdempotencyKey returns the idempotency key sent with the request, if any.
+func (r *Request) IdempotencyKey() string {
+	return r.HTTPRequest.Header.Get(IdempotencyKeyHeader)
+}
+
+// SetGeneratedIdempotencyKey sets an idempotency key generated by the SDK.
+// Generated keys are sent so that the API can recognize replays, but unlike
+// keys given with WithIdempotencyKey they do not make the request
+// idempotent: nothing guarantees the API honors them.
+func (r *Request) SetGeneratedIdempotencyKey(key string) {
+	r.HTTPRequest.Header.Set(IdempotencyKeyHeader, key)
+	r.idempotencyKeyGenerated = true
+}
+
+// IsIdempotent returns true if repeating the request cannot perform the
+// operation more than once. POST and PUT requests are only idempotent when
+// the caller gave them an idempotency key with WithIdempotencyKey.
+func (r *Request) IsIdempotent() bool {
+	switch r.HTTPRequest.Method {
+	case http.MethodPost, http.MethodPut:
+		return r.IdempotencyKey() != "" && !r.idempotencyKeyGenerated
+	default:
+		return true
+	}
+}
+
 // ApplyOptions will apply each option to the request calling them in the order
 // the were provided.
 func (r *Request) ApplyOptions(opts ...Option) {
@@ -302,21 +382,71 @@ func (r *Request) Send() error {
 
 	for {
 		r.Error = nil
+
+		// Wait for the shared rate limiter before every attempt
+		if err := r.Config.RateLimiter.Wait(r.Context()); err != nil {
+			r.Error = dcerr.New(CanceledErrorCode,
+				"request context canceled while waiting for rate limiter", err)
+			return r.Error
+		}
 		r.Time = time.Now()
 
+		// Sign every attempt so that retries never reuse stale credentials
+		r.Handlers.Sign.Run(r)
+		if r.Error != nil {
+			if r.Reauthenticating {
+				r.Error = dcerr.New(ErrCodeReauthenticationFailed,
+					"failed to re-authenticate after unauthorized response", r.Error)
+			}
+			return r.Error
+		}
+		r.Reauthenticating = false
+
 		if err := r.sendRequest(); err == nil {
+			r.releaseRetryQuota()
 			return nil
 		}
 
+		// Replay the request once with fresh credentials if the API rejected
+		// the ones it was signed with
+		if r.shouldReauthenticate() {
+			r.reauthenticated = true
+			r.Reauthenticating = true
+			if err := r.prepareRetry(); err != nil {
+				r.Error = err
+				return err
+			}
+			continue
+		}
+
 		// Check if we should retry
 		if !r.ShouldRetry(r) || r.RetryCount >= r.MaxRetries() {
 			return r.Error
 		}
 
+		// A failure other than rate limiting may have happened after the API
+		// performed the operation, only repeat it if that is safe
+		if !r.IsIdempotent() && !r.isRateLimited() {
+			logger.Debug("Not retrying non-idempotent %s without an idempotency key: %v",
+				r.Operation.Name, r.Error)
+			return r.Error
+		}
+
+		// Stop retrying once the shared retry quota is exhausted
+		if !r.Config.RetryQuota.Acquire(ratelimit.DefaultRetryCost) {
+			logger.Debug("Retry quota exhausted, not retrying %s: %v", r.Operation.Name, r.Error)
+			return r.Error
+		}
+		r.retryQuotaAcquired += ratelimit.DefaultRetryCost
+
 		// Calculate retry delay
 		r.RetryDelay = r.RetryRules(r)
-		if r.RetryDelay > 0 {
-			time.Sleep(r.RetryDelay)
+		logger.Debug("Retrying %s in %v (attempt %d, retry-after %v): %v",
+			r.Operation.Name, r.RetryDelay, r.RetryCount+1, r.RetryAfter, r.Error)
+		if err := sleepWithContext(r.Context(), r.RetryDelay); err != nil {
+			r.Error = dcerr.New(CanceledErrorCode,
+				"request context canceled before retry", err)
+			return r.Error
 		}
 
 		r.RetryCount++
@@ -329,6 +459,43 @@ func (r *Request) Send() error {
 	}
 }
 
+// releaseRetryQuota returns the retry quota taken by a request once it
+// succeeds, or rewards the quota if the request succeeded without retries.
+func (r *Request) releaseRetryQuota() {
+	if r.retryQuotaAcquired > 0 {
+		r.Config.RetryQuota.Release(r.retryQuotaAcquired)
+		r.retryQuotaAcquired = 0
+		return
+	}
+	r.Config.RetryQuota.Release(ratelimit.DefaultNoRetryIncrement)
+}
+
+// sleepWithContext waits for the given duration, returning early with the
+// context's error if it is canceled. If the context's deadline would expire
+// before the duration elapses it returns context.DeadlineExceeded without
+// waiting, as the next attempt could not complete in time.
+func sleepWithContext(ctx context.Context, dur time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if dur <= 0 {
+		return nil
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < dur {
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(dur)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 // sendRequest performs the actual HTTP request
 func (r *Request) sendRequest() error {
 	// Set the request body
@@ -339,14 +506,23 @@ func (r *Request) sendRequest() error {
 	}
 	r.HTTPRequest.Body = body
 
-	// Create HTTP client with context support
-	client := &http.Client{}
-	if r.context != nil {
-		r.HTTPRequest = r.HTTPRequest.WithContext(r.context)
+	// Bound each attempt by the configured timeout. The attempt context is
+	// only released once the response has been unmarshaled so that body
+	// reads are covered by the same deadline.
+	ctx := r.Context()
+	if r.Config.Timeout != nil && *r.Config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *r.Config.Timeout)
+		defer cancel()
 	}
+	r.HTTPRequest = r.HTTPRequest.WithContext(ctx)
+
+	// Forget the response of the previous attempt, so that a transport error
+	// is never classified by a stale status code
+	r.HTTPResponse = nil
 
 	// Perform the HTTP request
-	resp, err := client.Do(r.HTTPRequest)
+	resp, err := r.httpClient().Do(r.HTTPRequest)
 	if err != nil {
 		r.Error = dcerr.New(ErrCodeRequestError, "failed to send request", err)
 		return r.Error
@@ -359,6 +535,27 @@ func (r *Request) sendRequest() error {
 	return r.Error
 }
 
+// shouldReauthenticate returns true if an authenticated attempt was rejected
+// with HTTP 401 and the request has not been re-authenticated yet.
+func (r *Request) shouldReauthenticate() bool {
+	if r.reauthenticated || r.HTTPResponse == nil {
+		return false
+	}
+	if r.HTTPResponse.StatusCode != http.StatusUnauthorized {
+		return false
+	}
+	return r.HTTPRequest.Header.Get("Authorization") != ""
+}
+
+// httpClient returns the HTTP client configured for the request, falling back
+// to http.DefaultClient.
+func (r *Request) httpClient() *http.Client {
+	if r.Config.HTTPClient != nil {
+		return r.Config.HTTPClient
+	}
+	return http.DefaultClient
+}
+
 // prepareRetry prepares the request for retry by resetting the body.
 func (r *Request) prepareRetry() error {
 	// Reset the body to the beginning for retry if body exists
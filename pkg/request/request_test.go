@@ -0,0 +1,286 @@
+package request
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/config"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/ratelimit"
+)
+
+type countingTransport struct {
+	calls int32
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	atomic.AddInt32(&t.calls, 1)
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func newTestConfig(baseURL string, timeout time.Duration, client *http.Client) config.Config {
+	return config.Config{
+		BaseURL:    &baseURL,
+		Timeout:    &timeout,
+		HTTPClient: client,
+	}
+}
+
+func TestSend_UsesConfiguredHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := &countingTransport{}
+	cfg := newTestConfig(server.URL, time.Second, &http.Client{Transport: transport})
+
+	req := New(cfg, Handlers{}, nil, &Operation{Name: "Test", HTTPMethod: "GET", HTTPPath: "/test"}, nil, nil)
+	if err := req.Send(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&transport.calls); got != 1 {
+		t.Errorf("expected custom transport to be used once, got %d", got)
+	}
+}
+
+func TestSend_AppliesTimeoutPerAttempt(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := newTestConfig(server.URL, 50*time.Millisecond, nil)
+
+	req := New(cfg, Handlers{}, nil, &Operation{Name: "Test", HTTPMethod: "GET", HTTPPath: "/slow"}, nil, nil)
+
+	start := time.Now()
+	err := req.Send()
+	if err == nil {
+		t.Fatal("expected timeout error, got nil")
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected request to time out quickly, took %v", elapsed)
+	}
+}
+
+type fixedDelayRetryer struct {
+	delay time.Duration
+}
+
+func (r fixedDelayRetryer) RetryRules(*Request) time.Duration { return r.delay }
+func (r fixedDelayRetryer) ShouldRetry(*Request) bool         { return true }
+func (r fixedDelayRetryer) MaxRetries() int                   { return 3 }
+
+func newUnavailableServer(calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+}
+
+func newRetryingRequest(baseURL string) *Request {
+	handlers := Handlers{}
+	handlers.Unmarshal.PushFunc(func(r *Request) {
+		if r.HTTPResponse.StatusCode >= 300 {
+			r.Error = dcerr.NewHTTPError(r.HTTPResponse.StatusCode, "", nil)
+		}
+	})
+	return New(newTestConfig(baseURL, time.Second, nil), handlers, fixedDelayRetryer{delay: time.Minute},
+		&Operation{Name: "Test", HTTPMethod: "GET", HTTPPath: "/test"}, nil, nil)
+}
+
+func TestSend_CancelAbortsRetryDelay(t *testing.T) {
+	var calls int32
+	server := newUnavailableServer(&calls)
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := newRetryingRequest(server.URL)
+	req.SetContext(ctx)
+
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := req.Send()
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected cancellation to abort the retry delay, took %v", elapsed)
+	}
+
+	aerr, ok := err.(dcerr.Error)
+	if !ok || aerr.Code() != CanceledErrorCode {
+		t.Fatalf("expected %s error, got %v", CanceledErrorCode, err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected a single attempt, got %d", got)
+	}
+}
+
+func TestSend_DeadlineShorterThanRetryDelay(t *testing.T) {
+	var calls int32
+	server := newUnavailableServer(&calls)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	req := newRetryingRequest(server.URL)
+	req.SetContext(ctx)
+
+	start := time.Now()
+	err := req.Send()
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected Send to return without waiting, took %v", elapsed)
+	}
+
+	aerr, ok := err.(dcerr.Error)
+	if !ok || aerr.Code() != CanceledErrorCode {
+		t.Fatalf("expected %s error, got %v", CanceledErrorCode, err)
+	}
+	if aerr.OrigErr() != context.DeadlineExceeded {
+		t.Errorf("expected deadline exceeded cause, got %v", aerr.OrigErr())
+	}
+}
+
+func TestSend_StopsRetryingWhenQuotaExhausted(t *testing.T) {
+	var calls int32
+	server := newUnavailableServer(&calls)
+	defer server.Close()
+
+	req := newRetryingRequest(server.URL)
+	req.Retryer = fixedDelayRetryer{}
+	req.Config.RetryQuota = ratelimit.NewRetryQuota(ratelimit.DefaultRetryCost)
+
+	if err := req.Send(); dcerr.GetStatusCode(err) != http.StatusServiceUnavailable {
+		t.Fatalf("expected 503 error, got %v", err)
+	}
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected a single retry, got %d attempts", got)
+	}
+	if got := req.Config.RetryQuota.Available(); got != 0 {
+		t.Errorf("expected quota to be exhausted, got %d", got)
+	}
+}
+
+func TestSend_NonIdempotentRequestRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    int
+		key       string
+		generated bool
+		attempts  int32
+	}{
+		{name: "server error without key", status: http.StatusInternalServerError, attempts: 1},
+		{name: "server error with key", status: http.StatusInternalServerError, key: "key-1", attempts: 4},
+		{name: "bad gateway without key", status: http.StatusBadGateway, attempts: 1},
+		{name: "gateway timeout without key", status: http.StatusGatewayTimeout, attempts: 1},
+		{name: "bad gateway with generated key", status: http.StatusBadGateway, key: "generated-1", generated: true, attempts: 1},
+		{name: "bad gateway with key", status: http.StatusBadGateway, key: "key-1", attempts: 4},
+		{name: "throttled without key", status: http.StatusTooManyRequests, attempts: 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&calls, 1)
+				if got := r.Header.Get(IdempotencyKeyHeader); got != tt.key {
+					t.Errorf("expected idempotency key %q, got %q", tt.key, got)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			req := newRetryingRequest(server.URL)
+			req.Retryer = fixedDelayRetryer{}
+			req.HTTPRequest.Method = http.MethodPost
+			switch {
+			case tt.generated:
+				req.SetGeneratedIdempotencyKey(tt.key)
+			case tt.key != "":
+				req.ApplyOptions(WithIdempotencyKey(tt.key))
+			}
+
+			if err := req.Send(); err == nil {
+				t.Fatal("expected error, got nil")
+			}
+			if got := atomic.LoadInt32(&calls); got != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, got)
+			}
+		})
+	}
+}
+
+func TestWithDerivedIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name      string
+		key       string
+		generated bool
+		expected  string
+	}{
+		{name: "caller key", key: "batch-1", expected: "batch-1-i-1"},
+		{name: "no key", expected: ""},
+		{name: "generated key", key: "generated-1", generated: true, expected: "generated-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newRetryingRequest("http://localhost")
+			switch {
+			case tt.generated:
+				req.SetGeneratedIdempotencyKey(tt.key)
+			case tt.key != "":
+				req.ApplyOptions(WithIdempotencyKey(tt.key))
+			}
+			req.ApplyOptions(WithDerivedIdempotencyKey("i-1"))
+
+			if got := req.IdempotencyKey(); got != tt.expected {
+				t.Errorf("expected key %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSend_NonIdempotentRequestNotRetriedAfterResetFollowingThrottle(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		// Drop the connection without a response
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("failed to hijack connection: %v", err)
+			return
+		}
+		_ = conn.Close()
+	}))
+	defer server.Close()
+
+	req := newRetryingRequest(server.URL)
+	req.Retryer = fixedDelayRetryer{}
+	req.HTTPRequest.Method = http.MethodPost
+	req.Config.HTTPClient = &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+	err := req.Send()
+	if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != ErrCodeRequestError {
+		t.Fatalf("expected %s error, got %v", ErrCodeRequestError, err)
+	}
+	// The 429 is retried, the connection reset that follows is not
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected 2 attempts, got %d", got)
+	}
+	if req.HTTPResponse.StatusCode != 0 {
+		t.Errorf("expected no response status for the failed attempt, got %d", req.HTTPResponse.StatusCode)
+	}
+}
@@ -2,6 +2,7 @@ package request
 
 import (
 	"net"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -72,54 +73,8 @@ func (d noOpRetryer) RetryRules(_ *Request) time.Duration {
 	return 0
 }
 
-// retryableCodes is a collection of service response codes which are retry-able
-// without any further action.
-var retryableCodes = map[string]struct{}{
-	ErrCodeRequestError:       {},
-	"RequestTimeout":          {},
-	ErrCodeResponseTimeout:    {},
-	"RequestTimeoutException": {}, // Glacier's flavor of RequestTimeout
-}
-
-var throttleCodes = map[string]struct{}{
-	"ProvisionedThroughputExceededException": {},
-	"ThrottledException":                     {}, // SNS, XRay, ResourceGroupsTagging API
-	"Throttling":                             {},
-	"ThrottlingException":                    {},
-	"RequestLimitExceeded":                   {},
-	"RequestThrottled":                       {},
-	"RequestThrottledException":              {},
-	"TooManyRequestsException":               {}, // Lambda functions
-	"PriorRequestNotComplete":                {}, // Route53
-	"TransactionInProgressException":         {},
-	"EC2ThrottledException":                  {}, // EC2
-}
-
-// credsExpiredCodes is a collection of error codes which signify the credentials
-// need to be refreshed. Expired tokens require refreshing of credentials, and
-// resigning before the request can be retried.
-var credsExpiredCodes = map[string]struct{}{
-	"ExpiredToken":          {},
-	"ExpiredTokenException": {},
-	"RequestExpired":        {}, // EC2 Only
-}
-
-func isCodeThrottle(code string) bool {
-	_, ok := throttleCodes[code]
-	return ok
-}
-
 func isCodeRetryable(code string) bool {
-	if _, ok := retryableCodes[code]; ok {
-		return true
-	}
-
-	return isCodeExpiredCreds(code)
-}
-
-func isCodeExpiredCreds(code string) bool {
-	_, ok := credsExpiredCodes[code]
-	return ok
+	return DefaultErrorRegistry.CodeClass(code) == ErrorClassRetryable
 }
 
 var validParentCodes = map[string]struct{}{
@@ -167,8 +122,17 @@ type temporary interface {
 
 func shouldRetryError(origErr error) bool {
 	switch err := origErr.(type) {
+	case *dcerr.HTTPError:
+		// API responses are only retried when the registry says so
+		switch DefaultErrorRegistry.Classify(err) {
+		case ErrorClassRetryable, ErrorClassThrottle:
+			return true
+		default:
+			return false
+		}
+
 	case dcerr.Error:
-		if err.Code() == CanceledErrorCode {
+		if DefaultErrorRegistry.CodeClass(err.Code()) == ErrorClassNonRetryable {
 			return false
 		}
 		if isNestedErrorRetryable(err) {
@@ -228,19 +192,19 @@ func shouldRetryError(origErr error) bool {
 // IsErrorThrottle returns whether the error is to be throttled based on its code.
 // Returns false if error is nil.
 func IsErrorThrottle(err error) bool {
-	if aerr, ok := err.(dcerr.Error); ok && aerr != nil {
-		return isCodeThrottle(aerr.Code())
+	if err == nil {
+		return false
 	}
-	return false
+	return DefaultErrorRegistry.Classify(err) == ErrorClassThrottle
 }
 
 // IsErrorExpiredCreds returns whether the error code is a credential expiry
 // error. Returns false if error is nil.
 func IsErrorExpiredCreds(err error) bool {
-	if aerr, ok := err.(dcerr.Error); ok && aerr != nil {
-		return isCodeExpiredCreds(aerr.Code())
+	if err == nil {
+		return false
 	}
-	return false
+	return DefaultErrorRegistry.Classify(err) == ErrorClassExpiredCredentials
 }
 
 // IsErrorRetryable returns whether the error is retryable, based on its Code.
@@ -252,15 +216,8 @@ func (r *Request) IsErrorRetryable() bool {
 		return true
 	}
 
-	// HTTP response status code 501 should not be retried.
-	// 501 represents Not Implemented which means the request method is not
-	// supported by the server and cannot be handled.
-	if r.HTTPResponse != nil {
-		// HTTP response status code 500 represents internal server error and
-		// should be retried without any throttle.
-		if r.HTTPResponse.StatusCode == 500 {
-			return true
-		}
+	if r.responseStatusClass() == ErrorClassRetryable {
+		return true
 	}
 	return IsErrorRetryable(r.Error)
 }
@@ -274,20 +231,33 @@ func (r *Request) IsErrorThrottle() bool {
 		return true
 	}
 
-	if r.HTTPResponse != nil {
-		switch r.HTTPResponse.StatusCode {
-		case
-			429, // error caused due to too many requests
-			502, // Bad Gateway error should be throttled
-			503, // caused when service is unavailable
-			504: // error occurred due to gateway timeout
-			return true
-		}
+	if r.responseStatusClass() == ErrorClassThrottle {
+		return true
 	}
-
 	return IsErrorThrottle(r.Error)
 }
 
+// isRateLimited returns true if the last attempt was rejected with a 429 Too
+// Many Requests response. Unlike other throttling errors such as 502 or 504,
+// the API rejects rate limited requests before performing them.
+func (r *Request) isRateLimited() bool {
+	if dcerr.GetStatusCode(r.Error) == http.StatusTooManyRequests ||
+		dcerr.GetAPIErrorCode(r.Error) == dcerr.APIErrCodeRateLimitExceeded {
+		return true
+	}
+	return r.HTTPResponse != nil && r.HTTPResponse.StatusCode == http.StatusTooManyRequests
+}
+
+// responseStatusClass classifies the HTTP response status code of the last
+// attempt when the request error is not a dcerr.HTTPError carrying its own
+// status, for example when no error handler converted the response.
+func (r *Request) responseStatusClass() ErrorClass {
+	if _, ok := r.Error.(*dcerr.HTTPError); ok || r.HTTPResponse == nil {
+		return ErrorClassUnknown
+	}
+	return DefaultErrorRegistry.StatusCodeClass(r.HTTPResponse.StatusCode)
+}
+
 func isErrCode(err error, codes []string) bool {
 	if aerr, ok := err.(dcerr.Error); ok && aerr != nil {
 		for _, code := range codes {
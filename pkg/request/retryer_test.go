@@ -0,0 +1,120 @@
+package request
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+)
+
+func TestIsErrorRetryable_HTTPError(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		retryable bool
+		throttle  bool
+	}{
+		{
+			name: "bad request",
+			err:  dcerr.NewHTTPError(http.StatusBadRequest, `{"code":"invalid_request","message":"bad"}`, nil),
+		},
+		{
+			name: "not found without body",
+			err:  dcerr.NewHTTPError(http.StatusNotFound, "", nil),
+		},
+		{
+			name: "unauthorized",
+			err:  dcerr.NewHTTPError(http.StatusUnauthorized, `{"code":"unauthorized_request","message":"expired"}`, nil),
+		},
+		{
+			name:      "internal server error",
+			err:       dcerr.NewHTTPError(http.StatusInternalServerError, "", nil),
+			retryable: true,
+		},
+		{
+			name:      "service unavailable",
+			err:       dcerr.NewHTTPError(http.StatusServiceUnavailable, "", nil),
+			retryable: true,
+			throttle:  true,
+		},
+		{
+			name:      "rate limit code takes precedence over status",
+			err:       dcerr.NewHTTPError(http.StatusBadRequest, `{"code":"rate_limit_exceeded","message":"slow down"}`, nil),
+			retryable: true,
+			throttle:  true,
+		},
+		{
+			name: "not implemented",
+			err:  dcerr.NewHTTPError(http.StatusNotImplemented, "", nil),
+		},
+		{
+			name: "canceled",
+			err:  dcerr.New(CanceledErrorCode, "canceled", errors.New("context canceled")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsErrorRetryable(tt.err); got != tt.retryable {
+				t.Errorf("IsErrorRetryable() = %v, want %v", got, tt.retryable)
+			}
+			if got := IsErrorThrottle(tt.err); got != tt.throttle {
+				t.Errorf("IsErrorThrottle() = %v, want %v", got, tt.throttle)
+			}
+		})
+	}
+}
+
+func TestIsErrorExpiredCreds_HTTPError(t *testing.T) {
+	err := dcerr.NewHTTPError(http.StatusUnauthorized, "", nil)
+	if !IsErrorExpiredCreds(err) {
+		t.Error("expected 401 to be classified as expired credentials")
+	}
+}
+
+func TestErrorRegistry_Classify(t *testing.T) {
+	registry := NewErrorRegistry()
+	registry.RegisterCode("capacity_unavailable", ErrorClassThrottle)
+	registry.RegisterStatusCode(http.StatusConflict, ErrorClassRetryable)
+
+	tests := []struct {
+		name string
+		err  error
+		want ErrorClass
+	}{
+		{
+			name: "api code",
+			err:  dcerr.NewHTTPError(http.StatusBadRequest, `{"code":"capacity_unavailable","message":"no capacity"}`, nil),
+			want: ErrorClassThrottle,
+		},
+		{
+			name: "status code fallback",
+			err:  dcerr.NewHTTPError(http.StatusConflict, `{"code":"other","message":"conflict"}`, nil),
+			want: ErrorClassRetryable,
+		},
+		{
+			name: "sdk error code",
+			err:  dcerr.New("capacity_unavailable", "no capacity", nil),
+			want: ErrorClassThrottle,
+		},
+		{
+			name: "unregistered",
+			err:  dcerr.NewHTTPError(http.StatusTeapot, "", nil),
+			want: ErrorClassUnknown,
+		},
+		{
+			name: "plain error",
+			err:  errors.New("boom"),
+			want: ErrorClassUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := registry.Classify(tt.err); got != tt.want {
+				t.Errorf("Classify() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
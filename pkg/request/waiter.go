@@ -0,0 +1,262 @@
+package request
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+)
+
+// WaiterResourceNotReadyErrorCode is the error code returned by a waiter when
+// the resource reached a failure state or did not reach the desired state
+// within the maximum number of attempts.
+const WaiterResourceNotReadyErrorCode = "ResourceNotReady"
+
+// DefaultWaiterDelay is the delay between attempts of a waiter with no Delay.
+const DefaultWaiterDelay = 5 * time.Second
+
+// A WaiterOption is a function that will update the Waiter value's fields to
+// configure the waiter.
+type WaiterOption func(*Waiter)
+
+// WithWaiterMaxAttempts returns a waiter option which sets the maximum number
+// of times the waiter should attempt to check the resource for the target
+// state.
+func WithWaiterMaxAttempts(max int) WaiterOption {
+	return func(w *Waiter) {
+		w.MaxAttempts = max
+	}
+}
+
+// WaiterDelay will return a delay the waiter should pause between attempts to
+// check the resource state. The passed in attempt is the number of times the
+// Waiter has checked the resource state.
+type WaiterDelay func(attempt int) time.Duration
+
+// ConstantWaiterDelay returns a WaiterDelay that will always return a constant
+// delay the waiter should use between attempts.
+func ConstantWaiterDelay(delay time.Duration) WaiterDelay {
+	return func(attempt int) time.Duration {
+		return delay
+	}
+}
+
+// WithWaiterDelay will set the Waiter to use the WaiterDelay passed in.
+func WithWaiterDelay(delayer WaiterDelay) WaiterOption {
+	return func(w *Waiter) {
+		w.Delay = delayer
+	}
+}
+
+// WithWaiterRequestOptions will apply the passed in request options to the
+// requests made by the waiter.
+func WithWaiterRequestOptions(opts ...Option) WaiterOption {
+	return func(w *Waiter) {
+		w.RequestOptions = append(w.RequestOptions, opts...)
+	}
+}
+
+// A Waiter provides the functionality to perform a blocking call which will
+// wait for a resource state to be satisfied by a service.
+//
+// This type should not be used directly. The API operations provided in the
+// service packages prefixed with "WaitUntil" should be used instead.
+type Waiter struct {
+	Name      string
+	Acceptors []WaiterAcceptor
+
+	MaxAttempts int
+	Delay       WaiterDelay
+
+	RequestOptions []Option
+	NewRequest     func([]Option) (*Request, error)
+}
+
+// ApplyOptions updates the waiter with the list of waiter options provided.
+func (w *Waiter) ApplyOptions(opts ...WaiterOption) {
+	for _, fn := range opts {
+		fn(w)
+	}
+}
+
+// WaiterState are states the waiter uses based on WaiterAcceptor definitions
+// to identify if the resource state the waiter is waiting on has occurred.
+type WaiterState int
+
+// String returns the string representation of the waiter state.
+func (s WaiterState) String() string {
+	switch s {
+	case SuccessWaiterState:
+		return "success"
+	case FailureWaiterState:
+		return "failure"
+	case RetryWaiterState:
+		return "retry"
+	default:
+		return "unknown waiter state"
+	}
+}
+
+// States the waiter acceptors will use to identify target resource states.
+const (
+	SuccessWaiterState WaiterState = iota // waiter successful
+	FailureWaiterState                    // waiter failed
+	RetryWaiterState                      // waiter needs to be retried
+)
+
+// WaiterMatchMode is the mode that the waiter will use to match the
+// WaiterAcceptor definition's Expected attribute.
+type WaiterMatchMode int
+
+// Modes the waiter will use when inspecting API response to identify target
+// resource states.
+const (
+	// ValueWaiterMatch matches the value returned by the acceptor's Argument
+	// for the output of a successful request.
+	ValueWaiterMatch WaiterMatchMode = iota
+
+	// StatusWaiterMatch matches the HTTP status code of the response.
+	StatusWaiterMatch
+
+	// ErrorWaiterMatch matches the error code of the request error, or the
+	// API error code of an HTTPError.
+	ErrorWaiterMatch
+)
+
+// String returns the string representation of the waiter match mode.
+func (m WaiterMatchMode) String() string {
+	switch m {
+	case ValueWaiterMatch:
+		return "value"
+	case StatusWaiterMatch:
+		return "status"
+	case ErrorWaiterMatch:
+		return "error"
+	default:
+		return "unknown waiter match mode"
+	}
+}
+
+// WaitWithContext will make requests for the API operation using NewRequest
+// to build API requests. The request's response will be compared against the
+// Waiter's Acceptors to determine the successful state of the resource the
+// waiter is inspecting.
+//
+// The passed in context must not be nil. If it is canceled, or its deadline
+// would be exceeded before the next attempt, a CanceledErrorCode error is
+// returned.
+//
+// MaxAttempts must be positive. Waiters without a Delay wait
+// DefaultWaiterDelay between attempts.
+func (w Waiter) WaitWithContext(ctx context.Context) error {
+	if w.MaxAttempts <= 0 {
+		return dcerr.New(ErrCodeInvalidParameter,
+			fmt.Sprintf("%s max attempts must be positive, got %d", w.Name, w.MaxAttempts), nil)
+	}
+	if w.Delay == nil {
+		w.Delay = ConstantWaiterDelay(DefaultWaiterDelay)
+	}
+
+	for attempt := 1; ; attempt++ {
+		req, err := w.NewRequest(w.RequestOptions)
+		if err != nil {
+			return dcerr.New(WaiterResourceNotReadyErrorCode,
+				fmt.Sprintf("%s failed to create request", w.Name), err)
+		}
+		req.SetContext(ctx)
+		err = req.Send()
+
+		// See if any of the acceptors match the request's response or error
+		retry := false
+		for _, a := range w.Acceptors {
+			if !a.match(w.Name, req, err) {
+				continue
+			}
+			if a.State == RetryWaiterState {
+				retry = true
+				break
+			}
+			return a.result(w.Name, err)
+		}
+
+		// Errors no acceptor expected are returned as is, the request has
+		// already been retried by its retryer
+		if err != nil && !retry {
+			return err
+		}
+
+		// The Waiter should only check the resource state MaxAttempts times.
+		// This is here instead of in the for loop above to prevent delaying
+		// unnecessarily when the waiter will not retry.
+		if attempt >= w.MaxAttempts {
+			break
+		}
+
+		// Delay to wait before inspecting the resource again
+		delay := w.Delay(attempt)
+		logger.Debug("%s: resource not ready after attempt %d, retrying in %v", w.Name, attempt, delay)
+		if err := sleepWithContext(ctx, delay); err != nil {
+			return dcerr.New(CanceledErrorCode, "waiter context canceled", err)
+		}
+	}
+
+	return dcerr.New(WaiterResourceNotReadyErrorCode,
+		fmt.Sprintf("%s exceeded %d wait attempts", w.Name, w.MaxAttempts), nil)
+}
+
+// A WaiterAcceptor provides the information needed to wait for an API
+// operation to complete.
+type WaiterAcceptor struct {
+	State    WaiterState
+	Matcher  WaiterMatchMode
+	Expected interface{}
+
+	// Argument extracts the value compared with Expected from the output of
+	// a successful request. It is only used by ValueWaiterMatch acceptors.
+	Argument func(data interface{}) interface{}
+}
+
+// match returns if the acceptor matches the passed in request or error.
+func (a *WaiterAcceptor) match(name string, req *Request, err error) bool {
+	switch a.Matcher {
+	case ValueWaiterMatch:
+		return err == nil && a.Argument != nil && a.Argument(req.Data) == a.Expected
+	case StatusWaiterMatch:
+		return req.HTTPResponse != nil && req.HTTPResponse.StatusCode == a.Expected
+	case ErrorWaiterMatch:
+		return err != nil && errorCode(err) == a.Expected
+	default:
+		logger.Debug("%s: unknown acceptor match mode %v", name, a.Matcher)
+		return false
+	}
+}
+
+// result returns the outcome of the waiter once the acceptor matched.
+func (a *WaiterAcceptor) result(name string, err error) error {
+	switch a.State {
+	case SuccessWaiterState:
+		// Waiter completed
+		return nil
+	case FailureWaiterState:
+		// Waiter failure state triggered
+		return dcerr.New(WaiterResourceNotReadyErrorCode,
+			fmt.Sprintf("%s reached failure state %v", name, a.Expected), err)
+	default:
+		return dcerr.New(WaiterResourceNotReadyErrorCode,
+			fmt.Sprintf("%s reached unknown waiter state %v", name, a.State), err)
+	}
+}
+
+// errorCode returns the code identifying err, preferring the API error code
+// of an HTTPError.
+func errorCode(err error) string {
+	if _, ok := dcerr.IsHTTPError(err); ok {
+		return dcerr.GetAPIErrorCode(err)
+	}
+	if aerr, ok := err.(dcerr.Error); ok {
+		return aerr.Code()
+	}
+	return ""
+}
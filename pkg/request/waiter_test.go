@@ -0,0 +1,195 @@
+package request
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+)
+
+// newStatusServer returns a server responding with the given statuses in
+// order, repeating the last one.
+func newStatusServer(statuses ...string) (*httptest.Server, *int32) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := int(atomic.AddInt32(&calls, 1))
+		if n > len(statuses) {
+			n = len(statuses)
+		}
+		if statuses[n-1] == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"not_found","message":"not found"}`))
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]string{"status": statuses[n-1]})
+	}))
+	return server, &calls
+}
+
+func newStatusWaiter(baseURL string, acceptors ...WaiterAcceptor) Waiter {
+	return Waiter{
+		Name:        "WaitUntilTest",
+		MaxAttempts: 5,
+		Delay:       ConstantWaiterDelay(time.Millisecond),
+		Acceptors:   acceptors,
+		NewRequest: func(opts []Option) (*Request, error) {
+			handlers := Handlers{}
+			handlers.Unmarshal.PushFunc(func(r *Request) {
+				if r.HTTPResponse.StatusCode >= 300 {
+					r.Error = dcerr.NewHTTPError(r.HTTPResponse.StatusCode, `{"code":"not_found","message":"not found"}`, nil)
+					return
+				}
+				_ = json.NewDecoder(r.HTTPResponse.Body).Decode(r.Data)
+			})
+			req := New(newTestConfig(baseURL, time.Second, nil), handlers, nil,
+				&Operation{Name: "GetTest", HTTPMethod: "GET", HTTPPath: "/test"}, nil, &map[string]string{})
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+}
+
+func statusAcceptor(state WaiterState, status string) WaiterAcceptor {
+	return WaiterAcceptor{
+		State:    state,
+		Matcher:  ValueWaiterMatch,
+		Expected: status,
+		Argument: func(data interface{}) interface{} {
+			return (*data.(*map[string]string))["status"]
+		},
+	}
+}
+
+func TestWaiter_Success(t *testing.T) {
+	server, calls := newStatusServer("provisioning", "provisioning", "running")
+	defer server.Close()
+
+	w := newStatusWaiter(server.URL,
+		statusAcceptor(SuccessWaiterState, "running"),
+		statusAcceptor(FailureWaiterState, "error"))
+
+	if err := w.WaitWithContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestWaiter_FailureState(t *testing.T) {
+	server, _ := newStatusServer("provisioning", "error")
+	defer server.Close()
+
+	w := newStatusWaiter(server.URL,
+		statusAcceptor(SuccessWaiterState, "running"),
+		statusAcceptor(FailureWaiterState, "error"))
+
+	err := w.WaitWithContext(context.Background())
+	if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != WaiterResourceNotReadyErrorCode {
+		t.Fatalf("expected %s error, got %v", WaiterResourceNotReadyErrorCode, err)
+	}
+}
+
+func TestWaiter_MaxAttempts(t *testing.T) {
+	server, calls := newStatusServer("provisioning")
+	defer server.Close()
+
+	w := newStatusWaiter(server.URL, statusAcceptor(SuccessWaiterState, "running"))
+	w.ApplyOptions(WithWaiterMaxAttempts(3))
+
+	err := w.WaitWithContext(context.Background())
+	if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != WaiterResourceNotReadyErrorCode {
+		t.Fatalf("expected %s error, got %v", WaiterResourceNotReadyErrorCode, err)
+	}
+	if got := atomic.LoadInt32(calls); got != 3 {
+		t.Errorf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestWaiter_InvalidMaxAttempts(t *testing.T) {
+	for _, maxAttempts := range []int{0, -1} {
+		server, calls := newStatusServer("provisioning")
+		defer server.Close()
+
+		w := newStatusWaiter(server.URL, statusAcceptor(SuccessWaiterState, "running"))
+		w.ApplyOptions(WithWaiterMaxAttempts(maxAttempts))
+
+		err := w.WaitWithContext(context.Background())
+		if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != ErrCodeInvalidParameter {
+			t.Fatalf("max attempts %d: expected %s error, got %v", maxAttempts, ErrCodeInvalidParameter, err)
+		}
+		if got := atomic.LoadInt32(calls); got != 0 {
+			t.Errorf("max attempts %d: expected no attempts, got %d", maxAttempts, got)
+		}
+	}
+}
+
+func TestWaiter_DefaultDelay(t *testing.T) {
+	server, calls := newStatusServer("provisioning")
+	defer server.Close()
+
+	w := newStatusWaiter(server.URL, statusAcceptor(SuccessWaiterState, "running"))
+	w.Delay = nil
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	err := w.WaitWithContext(ctx)
+	if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != CanceledErrorCode {
+		t.Fatalf("expected %s error, got %v", CanceledErrorCode, err)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("expected a single attempt before the default delay, got %d", got)
+	}
+}
+
+func TestWaiter_ErrorAcceptors(t *testing.T) {
+	server, _ := newStatusServer("running", "missing")
+	defer server.Close()
+
+	w := newStatusWaiter(server.URL, WaiterAcceptor{
+		State:    SuccessWaiterState,
+		Matcher:  ErrorWaiterMatch,
+		Expected: "not_found",
+	})
+	if err := w.WaitWithContext(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Errors no acceptor matches end the wait
+	server, calls := newStatusServer("missing")
+	defer server.Close()
+
+	w = newStatusWaiter(server.URL, statusAcceptor(SuccessWaiterState, "running"))
+	if err := w.WaitWithContext(context.Background()); dcerr.GetStatusCode(err) != http.StatusNotFound {
+		t.Fatalf("expected 404 error, got %v", err)
+	}
+	if got := atomic.LoadInt32(calls); got != 1 {
+		t.Errorf("expected a single attempt, got %d", got)
+	}
+}
+
+func TestWaiter_ContextCanceled(t *testing.T) {
+	server, _ := newStatusServer("provisioning")
+	defer server.Close()
+
+	w := newStatusWaiter(server.URL, statusAcceptor(SuccessWaiterState, "running"))
+	w.ApplyOptions(WithWaiterDelay(ConstantWaiterDelay(time.Minute)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := w.WaitWithContext(ctx)
+	if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != CanceledErrorCode {
+		t.Fatalf("expected %s error, got %v", CanceledErrorCode, err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("expected cancellation to stop the waiter, took %v", elapsed)
+	}
+}
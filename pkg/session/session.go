@@ -2,6 +2,7 @@ package session
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/config"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/credentials"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/defaults"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/ratelimit"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 )
 
@@ -27,6 +29,7 @@ type Options struct {
 	ClientID     string
 	ClientSecret string
 	Timeout      time.Duration
+	HTTPClient   *http.Client
 
 	// Credential configuration
 	Credentials                   *credentials.Credentials
@@ -36,6 +39,16 @@ type Options struct {
 	MaxRetries *int
 	Retryer    interface{}
 
+	// Rate limiting configuration. RateLimit is the number of requests per
+	// second allowed across all clients of the session, with bursts of up to
+	// RateLimitBurst requests. Zero disables rate limiting.
+	RateLimit      float64
+	RateLimitBurst int
+
+	// RetryQuota is the capacity of the retry token bucket shared by all
+	// clients of the session. Zero disables the retry quota.
+	RetryQuota int
+
 	// Logging configuration
 	Debug bool
 }
@@ -86,8 +99,11 @@ func New(options ...func(*Options)) *Session {
 	cfg := &config.Config{
 		BaseURL:     &finalBaseURL,
 		Timeout:     &opts.Timeout,
+		HTTPClient:  opts.HTTPClient,
 		MaxRetries:  opts.MaxRetries,
 		Retryer:     opts.Retryer,
+		RateLimiter: opts.rateLimiter(),
+		RetryQuota:  opts.retryQuota(),
 		Credentials: creds,
 		Debug:       opts.Debug,
 	}
@@ -144,8 +160,11 @@ func NewFromEnv(options ...func(*Options)) *Session {
 	cfg := &config.Config{
 		BaseURL:     &opts.BaseURL,
 		Timeout:     &opts.Timeout,
+		HTTPClient:  opts.HTTPClient,
 		MaxRetries:  opts.MaxRetries,
 		Retryer:     opts.Retryer,
+		RateLimiter: opts.rateLimiter(),
+		RetryQuota:  opts.retryQuota(),
 		Credentials: envCreds,
 		Debug:       opts.Debug,
 	}
@@ -157,6 +176,24 @@ func NewFromEnv(options ...func(*Options)) *Session {
 	}
 }
 
+// rateLimiter returns the rate limiter configured by the options, or nil if
+// rate limiting is disabled
+func (o *Options) rateLimiter() *ratelimit.Limiter {
+	if o.RateLimit <= 0 {
+		return nil
+	}
+	return ratelimit.NewLimiter(o.RateLimit, o.RateLimitBurst)
+}
+
+// retryQuota returns the retry quota configured by the options, or nil if it
+// is disabled
+func (o *Options) retryQuota() *ratelimit.RetryQuota {
+	if o.RetryQuota <= 0 {
+		return nil
+	}
+	return ratelimit.NewRetryQuota(o.RetryQuota)
+}
+
 // WithBaseURL sets the base URL for the API
 func WithBaseURL(baseURL string) func(*Options) {
 	return func(o *Options) {
@@ -198,6 +235,30 @@ func WithTimeout(timeout time.Duration) func(*Options) {
 	}
 }
 
+// WithHTTPClient sets the HTTP client used for API and token requests
+func WithHTTPClient(client *http.Client) func(*Options) {
+	return func(o *Options) {
+		o.HTTPClient = client
+	}
+}
+
+// WithRateLimit limits requests made by all clients of the session to
+// requestsPerSecond, with bursts of up to burst requests
+func WithRateLimit(requestsPerSecond float64, burst int) func(*Options) {
+	return func(o *Options) {
+		o.RateLimit = requestsPerSecond
+		o.RateLimitBurst = burst
+	}
+}
+
+// WithRetryQuota limits retries made by all clients of the session with a
+// retry token bucket of the given capacity
+func WithRetryQuota(capacity int) func(*Options) {
+	return func(o *Options) {
+		o.RetryQuota = capacity
+	}
+}
+
 // WithCredentials sets custom credentials
 func WithCredentialsProvider(creds *credentials.Credentials) func(*Options) {
 	return func(o *Options) {
@@ -1,6 +1,8 @@
 package instance
 
 import (
+	"context"
+
 	"github.com/datacrunch-io/datacrunch-sdk-go/internal/protocol/restjson"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 )
@@ -25,46 +27,46 @@ type OSVolume struct {
 
 // CreateInstanceInput represents the input for creating a new instance
 type CreateInstanceInput struct {
-	InstanceType    string    `json:"instance_type"`
-	Image           string    `json:"image"`
-	SSHKeyIDs       []string  `json:"ssh_key_ids"`
-	StartupScriptID string    `json:"startup_script_id,omitempty"`
-	Hostname        string    `json:"hostname,omitempty"`
-	Description     string    `json:"description,omitempty"`
-	LocationCode    string    `json:"location_code"`
-	OSVolume        *OSVolume `json:"os_volume,omitempty"`
-	IsSpot          bool      `json:"is_spot"`
-	Volumes         []Volume  `json:"volumes,omitempty"`
-	ExistingVolumes []string  `json:"existing_volumes,omitempty"`
-	Contract        string    `json:"contract"`
-	Pricing         string    `json:"pricing"`
+	InstanceType    string       `json:"instance_type"`
+	Image           string       `json:"image"`
+	SSHKeyIDs       []string     `json:"ssh_key_ids"`
+	StartupScriptID string       `json:"startup_script_id,omitempty"`
+	Hostname        string       `json:"hostname,omitempty"`
+	Description     string       `json:"description,omitempty"`
+	LocationCode    string       `json:"location_code"`
+	OSVolume        *OSVolume    `json:"os_volume,omitempty"`
+	IsSpot          bool         `json:"is_spot"`
+	Volumes         []Volume     `json:"volumes,omitempty"`
+	ExistingVolumes []string     `json:"existing_volumes,omitempty"`
+	Contract        ContractType `json:"contract"`
+	Pricing         PricingType  `json:"pricing"`
 }
 
 // ListInstancesResponse represents a compute instance
 type ListInstancesResponse struct {
-	ID              string   `json:"id"`
-	IP              string   `json:"ip"`
-	Status          string   `json:"status"`
-	CreatedAt       string   `json:"created_at"`
-	CPU             CPU      `json:"cpu"`
-	GPU             GPU      `json:"gpu"`
-	GPUMemory       Memory   `json:"gpu_memory"`
-	Memory          Memory   `json:"memory"`
-	Storage         Storage  `json:"storage"`
-	Hostname        string   `json:"hostname"`
-	Description     string   `json:"description"`
-	Location        string   `json:"location"`
-	PricePerHour    float64  `json:"price_per_hour"`
-	IsSpot          bool     `json:"is_spot"`
-	InstanceType    string   `json:"instance_type"`
-	Image           string   `json:"image"`
-	OSName          string   `json:"os_name"`
-	StartupScriptID *string  `json:"startup_script_id"` // Changed to pointer for null values
-	SSHKeyIDs       []string `json:"ssh_key_ids"`
-	OSVolumeID      string   `json:"os_volume_id"`
-	JupyterToken    *string  `json:"jupyter_token"` // Changed to pointer for null values
-	Contract        string   `json:"contract"`
-	Pricing         string   `json:"pricing"`
+	ID              string         `json:"id"`
+	IP              string         `json:"ip"`
+	Status          InstanceStatus `json:"status"`
+	CreatedAt       string         `json:"created_at"`
+	CPU             CPU            `json:"cpu"`
+	GPU             GPU            `json:"gpu"`
+	GPUMemory       Memory         `json:"gpu_memory"`
+	Memory          Memory         `json:"memory"`
+	Storage         Storage        `json:"storage"`
+	Hostname        string         `json:"hostname"`
+	Description     string         `json:"description"`
+	Location        string         `json:"location"`
+	PricePerHour    float64        `json:"price_per_hour"`
+	IsSpot          bool           `json:"is_spot"`
+	InstanceType    string         `json:"instance_type"`
+	Image           string         `json:"image"`
+	OSName          string         `json:"os_name"`
+	StartupScriptID *string        `json:"startup_script_id"` // Changed to pointer for null values
+	SSHKeyIDs       []string       `json:"ssh_key_ids"`
+	OSVolumeID      string         `json:"os_volume_id"`
+	JupyterToken    *string        `json:"jupyter_token"` // Changed to pointer for null values
+	Contract        ContractType   `json:"contract"`
+	Pricing         PricingType    `json:"pricing"`
 }
 
 // CPU represents CPU configuration
@@ -116,6 +118,9 @@ type InstanceActionInput struct {
 	Action    InstanceActionType `json:"action"`
 	ID        string             `json:"id"`
 	VolumeIDs []string           `json:"volume_ids,omitempty"`
+
+	// OnSpotDiscontinue is only used by the configure_spot action
+	OnSpotDiscontinue SpotDiscontinuePolicy `json:"on_spot_discontinue,omitempty"`
 }
 
 // InstanceStatus represents the status of an instance
@@ -137,25 +142,72 @@ const (
 
 // ListInstancesInput represents the input for listing instances
 type ListInstancesInput struct {
-	Status string `location:"querystring" locationName:"status"`
+	Status InstanceStatus `location:"querystring" locationName:"status"`
 }
 
 // ListInstances lists all instances
 func (c *Instance) ListInstances(input *ListInstancesInput) ([]*ListInstancesResponse, error) {
+	return c.ListInstancesWithContext(context.Background(), input)
+}
+
+// ListInstancesWithContext is the same as ListInstances with the addition of
+// the ability to pass a context and additional request options.
+func (c *Instance) ListInstancesWithContext(ctx context.Context, input *ListInstancesInput, opts ...request.Option) ([]*ListInstancesResponse, error) {
+	req, instances := c.listInstancesRequest(input)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+
+	err := req.Send()
+	return *instances, err
+}
+
+// listInstancesRequest generates a request for the ListInstances operation.
+func (c *Instance) listInstancesRequest(input *ListInstancesInput) (*request.Request, *[]*ListInstancesResponse) {
 	op := &request.Operation{
 		Name:       "ListInstances",
 		HTTPMethod: "GET",
 		HTTPPath:   "/instances",
 	}
 
-	var instances []*ListInstancesResponse
-	req := c.newRequest(op, input, &instances)
+	instances := new([]*ListInstancesResponse)
+	return c.newRequest(op, input, instances), instances
+}
 
-	return instances, req.Send()
+// GetInstanceInput represents the input for getting an instance
+type GetInstanceInput struct {
+	ID string `location:"uri" locationName:"id"`
+}
+
+// GetInstance gets an instance by ID
+func (c *Instance) GetInstance(id string) (*ListInstancesResponse, error) {
+	return c.GetInstanceWithContext(context.Background(), id)
+}
+
+// GetInstanceWithContext is the same as GetInstance with the addition of the
+// ability to pass a context and additional request options.
+func (c *Instance) GetInstanceWithContext(ctx context.Context, id string, opts ...request.Option) (*ListInstancesResponse, error) {
+	op := &request.Operation{
+		Name:       "GetInstance",
+		HTTPMethod: "GET",
+		HTTPPath:   "/instances/{id}",
+	}
+
+	var instance ListInstancesResponse
+	req := c.newRequest(op, &GetInstanceInput{ID: id}, &instance)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+
+	return &instance, req.Send()
 }
 
 // CreateInstance creates a new compute instance
 func (c *Instance) CreateInstance(input *CreateInstanceInput) (string, error) {
+	return c.CreateInstanceWithContext(context.Background(), input)
+}
+
+// CreateInstanceWithContext is the same as CreateInstance with the addition of
+// the ability to pass a context and additional request options.
+func (c *Instance) CreateInstanceWithContext(ctx context.Context, input *CreateInstanceInput, opts ...request.Option) (string, error) {
 	op := &request.Operation{
 		Name:       "CreateInstance",
 		HTTPMethod: "POST",
@@ -164,11 +216,13 @@ func (c *Instance) CreateInstance(input *CreateInstanceInput) (string, error) {
 
 	var instanceID string
 	req := c.newRequest(op, input, &instanceID)
+	req.SetContext(ctx)
 
 	// Replace the JSON unmarshaler with string unmarshaler for the instance ID response
 	// The default error handler will still run first
 	req.Handlers.Unmarshal.RemoveByName("datacrunchsdk.restjson.Unmarshal")
 	req.Handlers.Unmarshal.PushBackNamed(restjson.StringUnmarshalHandler)
+	req.ApplyOptions(opts...)
 
 	err := req.Send()
 	if err != nil {
@@ -180,6 +234,12 @@ func (c *Instance) CreateInstance(input *CreateInstanceInput) (string, error) {
 
 // PerformInstanceAction performs an action on an instance
 func (c *Instance) PerformInstanceAction(input *InstanceActionInput) error {
+	return c.PerformInstanceActionWithContext(context.Background(), input)
+}
+
+// PerformInstanceActionWithContext is the same as PerformInstanceAction with
+// the addition of the ability to pass a context and additional request options.
+func (c *Instance) PerformInstanceActionWithContext(ctx context.Context, input *InstanceActionInput, opts ...request.Option) error {
 	op := &request.Operation{
 		Name:       "PerformInstanceAction",
 		HTTPMethod: "PUT",
@@ -187,6 +247,66 @@ func (c *Instance) PerformInstanceAction(input *InstanceActionInput) error {
 	}
 
 	req := c.newRequest(op, input, nil)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return req.Send()
 }
+
+// StartInstance starts an offline instance
+func (c *Instance) StartInstance(id string) error {
+	return c.StartInstanceWithContext(context.Background(), id)
+}
+
+// StartInstanceWithContext is the same as StartInstance with the addition of
+// the ability to pass a context and additional request options.
+func (c *Instance) StartInstanceWithContext(ctx context.Context, id string, opts ...request.Option) error {
+	return c.PerformInstanceActionWithContext(ctx, &InstanceActionInput{
+		Action: InstanceActionStart,
+		ID:     id,
+	}, opts...)
+}
+
+// ShutdownInstance shuts down a running instance
+func (c *Instance) ShutdownInstance(id string) error {
+	return c.ShutdownInstanceWithContext(context.Background(), id)
+}
+
+// ShutdownInstanceWithContext is the same as ShutdownInstance with the
+// addition of the ability to pass a context and additional request options.
+func (c *Instance) ShutdownInstanceWithContext(ctx context.Context, id string, opts ...request.Option) error {
+	return c.PerformInstanceActionWithContext(ctx, &InstanceActionInput{
+		Action: InstanceActionShutdown,
+		ID:     id,
+	}, opts...)
+}
+
+// HibernateInstance hibernates an instance
+func (c *Instance) HibernateInstance(id string) error {
+	return c.HibernateInstanceWithContext(context.Background(), id)
+}
+
+// HibernateInstanceWithContext is the same as HibernateInstance with the
+// addition of the ability to pass a context and additional request options.
+func (c *Instance) HibernateInstanceWithContext(ctx context.Context, id string, opts ...request.Option) error {
+	return c.PerformInstanceActionWithContext(ctx, &InstanceActionInput{
+		Action: InstanceActionHibernate,
+		ID:     id,
+	}, opts...)
+}
+
+// DeleteInstance deletes an instance along with the given attached volumes.
+// Attached volumes not listed in volumeIDs are detached and kept.
+func (c *Instance) DeleteInstance(id string, volumeIDs []string) error {
+	return c.DeleteInstanceWithContext(context.Background(), id, volumeIDs)
+}
+
+// DeleteInstanceWithContext is the same as DeleteInstance with the addition
+// of the ability to pass a context and additional request options.
+func (c *Instance) DeleteInstanceWithContext(ctx context.Context, id string, volumeIDs []string, opts ...request.Option) error {
+	return c.PerformInstanceActionWithContext(ctx, &InstanceActionInput{
+		Action:    InstanceActionDelete,
+		ID:        id,
+		VolumeIDs: volumeIDs,
+	}, opts...)
+}
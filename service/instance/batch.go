@@ -0,0 +1,109 @@
+package instance
+
+import (
+	"context"
+	"sync"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+// DefaultBatchConcurrency is the number of instance actions performed at once
+// by PerformInstanceActions when MaxConcurrency is not set.
+const DefaultBatchConcurrency = 8
+
+// BatchInstanceActionInput represents the input for performing an action on
+// many instances
+type BatchInstanceActionInput struct {
+	Action    InstanceActionType
+	IDs       []string
+	VolumeIDs []string
+
+	// MaxConcurrency bounds the number of actions in flight at once. If zero,
+	// DefaultBatchConcurrency is used.
+	MaxConcurrency int
+}
+
+// PerformInstanceActions performs an action on many instances. Every ID is
+// acted upon even if some of them fail; the returned map holds the outcome
+// for each ID, nil on success. The error is only set if the input is invalid.
+func (c *Instance) PerformInstanceActions(input *BatchInstanceActionInput) (map[string]error, error) {
+	return c.PerformInstanceActionsWithContext(context.Background(), input)
+}
+
+// PerformInstanceActionsWithContext is the same as PerformInstanceActions with
+// the addition of the ability to pass a context and additional request
+// options. Actions not started before the context is canceled fail with a
+// request.CanceledErrorCode error.
+//
+// The options are applied to the request of every ID. An idempotency key given
+// with request.WithIdempotencyKey is suffixed with the ID, so that each
+// request has its own key.
+func (c *Instance) PerformInstanceActionsWithContext(ctx context.Context, input *BatchInstanceActionInput, opts ...request.Option) (map[string]error, error) {
+	if input == nil || len(input.IDs) == 0 {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter, "at least one instance ID is required", nil)
+	}
+	if input.Action == "" {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter, "an instance action is required", nil)
+	}
+
+	concurrency := input.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBatchConcurrency
+	}
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[string]error, len(input.IDs))
+		sem     = make(chan struct{}, concurrency)
+	)
+
+	seen := make(map[string]bool, len(input.IDs))
+	for _, id := range input.IDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		// select picks randomly when both cases are ready, check the context
+		// first so that no action starts once it is canceled
+		acquired := false
+		if ctx.Err() == nil {
+			select {
+			case sem <- struct{}{}:
+				acquired = true
+			case <-ctx.Done():
+			}
+		}
+		if !acquired {
+			mu.Lock()
+			results[id] = dcerr.New(request.CanceledErrorCode, "request context canceled", ctx.Err())
+			mu.Unlock()
+			continue
+		}
+
+		idOpts := append(append([]request.Option{}, opts...), request.WithDerivedIdempotencyKey(id))
+
+		wg.Add(1)
+		go func(id string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			err := c.PerformInstanceActionWithContext(ctx, &InstanceActionInput{
+				Action:    input.Action,
+				ID:        id,
+				VolumeIDs: input.VolumeIDs,
+			}, idOpts...)
+
+			mu.Lock()
+			results[id] = err
+			mu.Unlock()
+		}(id)
+	}
+	wg.Wait()
+
+	return results, nil
+}
@@ -0,0 +1,145 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+func TestPerformInstanceActions_BoundsConcurrency(t *testing.T) {
+	var inFlight, maxInFlight int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ids := []string{"i-1", "i-2", "i-3", "i-4", "i-5", "i-6", "i-7", "i-8"}
+	results, err := c.PerformInstanceActions(&BatchInstanceActionInput{
+		Action:         InstanceActionShutdown,
+		IDs:            ids,
+		MaxConcurrency: 2,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(results) != len(ids) {
+		t.Fatalf("expected %d results, got %d", len(ids), len(results))
+	}
+	for id, err := range results {
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", id, err)
+		}
+	}
+	if got := atomic.LoadInt32(&maxInFlight); got > 2 {
+		t.Errorf("expected at most 2 actions in flight, got %d", got)
+	}
+}
+
+func TestPerformInstanceActions_PerIDResults(t *testing.T) {
+	var mu sync.Mutex
+	keys := map[string]string{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var body InstanceActionInput
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		mu.Lock()
+		keys[body.ID] = r.Header.Get(request.IdempotencyKeyHeader)
+		mu.Unlock()
+
+		if body.ID == "i-missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"code":"not_found","message":"instance not found"}`))
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	results, err := c.PerformInstanceActionsWithContext(context.Background(), &BatchInstanceActionInput{
+		Action: InstanceActionDelete,
+		IDs:    []string{"i-1", "i-missing", "i-2", "i-1"},
+	}, request.WithIdempotencyKey("fleet-delete"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results for the distinct IDs, got %d", len(results))
+	}
+	for _, id := range []string{"i-1", "i-2"} {
+		if err := results[id]; err != nil {
+			t.Errorf("%s: unexpected error: %v", id, err)
+		}
+	}
+	if err := results["i-missing"]; dcerr.GetStatusCode(err) != http.StatusNotFound {
+		t.Errorf("i-missing: expected 404 error, got %v", err)
+	}
+
+	for id, key := range keys {
+		if expected := "fleet-delete-" + id; key != expected {
+			t.Errorf("%s: expected idempotency key %q, got %q", id, expected, key)
+		}
+	}
+}
+
+func TestPerformInstanceActions_ContextCanceled(t *testing.T) {
+	var calls int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ids := []string{"i-1", "i-2", "i-3"}
+	results, err := c.PerformInstanceActionsWithContext(ctx, &BatchInstanceActionInput{
+		Action: InstanceActionShutdown,
+		IDs:    ids,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, id := range ids {
+		aerr, ok := results[id].(dcerr.Error)
+		if !ok || aerr.Code() != request.CanceledErrorCode {
+			t.Errorf("%s: expected %s error, got %v", id, request.CanceledErrorCode, results[id])
+		}
+	}
+	if got := atomic.LoadInt32(&calls); got != 0 {
+		t.Errorf("expected no action requests, got %d", got)
+	}
+}
+
+func TestPerformInstanceActions_InvalidInput(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	tests := []*BatchInstanceActionInput{
+		nil,
+		{Action: InstanceActionShutdown},
+		{IDs: []string{"i-1"}},
+	}
+	for _, input := range tests {
+		_, err := c.PerformInstanceActions(input)
+		if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != request.ErrCodeInvalidParameter {
+			t.Errorf("%+v: expected %s error, got %v", input, request.ErrCodeInvalidParameter, err)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package instance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instancetypes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/locations"
+)
+
+// MinOSVolumeSize is the smallest OS volume size in GB accepted by the API,
+// as an OS volume must hold the image. Build rejects smaller sizes.
+var MinOSVolumeSize int64 = 50
+
+// InstanceTypeLister lists the available instance types. It is satisfied by
+// the instancetypes client.
+type InstanceTypeLister interface {
+	ListInstanceTypesWithContext(ctx context.Context, opts ...request.Option) ([]*instancetypes.InstanceTypeResponse, error)
+}
+
+// LocationLister lists the available locations. It is satisfied by the
+// locations client.
+type LocationLister interface {
+	ListLocationsWithContext(ctx context.Context, opts ...request.Option) ([]*locations.LocationResponse, error)
+}
+
+// CreateInstanceBuilder builds a CreateInstanceInput, validating it locally
+// before it is sent so that mistakes are reported together instead of one
+// 400 response at a time.
+type CreateInstanceBuilder struct {
+	input CreateInstanceInput
+
+	instanceTypes InstanceTypeLister
+	locations     LocationLister
+}
+
+// NewCreateInstanceBuilder returns a builder for an instance of the given
+// type, image and location. The contract defaults to ContractPayAsYouGo and
+// pricing to PricingFixedPrice.
+func NewCreateInstanceBuilder(instanceType, image, locationCode string) *CreateInstanceBuilder {
+	return &CreateInstanceBuilder{
+		input: CreateInstanceInput{
+			InstanceType: instanceType,
+			Image:        image,
+			LocationCode: locationCode,
+			Contract:     ContractPayAsYouGo,
+			Pricing:      PricingFixedPrice,
+		},
+	}
+}
+
+// WithSSHKeys adds SSH keys allowed to access the instance
+func (b *CreateInstanceBuilder) WithSSHKeys(ids ...string) *CreateInstanceBuilder {
+	b.input.SSHKeyIDs = append(b.input.SSHKeyIDs, ids...)
+	return b
+}
+
+// WithStartupScript sets the startup script run when the instance boots
+func (b *CreateInstanceBuilder) WithStartupScript(id string) *CreateInstanceBuilder {
+	b.input.StartupScriptID = id
+	return b
+}
+
+// WithHostname sets the hostname of the instance
+func (b *CreateInstanceBuilder) WithHostname(hostname string) *CreateInstanceBuilder {
+	b.input.Hostname = hostname
+	return b
+}
+
+// WithDescription sets the description of the instance
+func (b *CreateInstanceBuilder) WithDescription(description string) *CreateInstanceBuilder {
+	b.input.Description = description
+	return b
+}
+
+// WithOSVolume sets the name and size in GB of the OS volume, at least
+// MinOSVolumeSize
+func (b *CreateInstanceBuilder) WithOSVolume(name string, size int64) *CreateInstanceBuilder {
+	b.input.OSVolume = &OSVolume{Name: name, Size: size}
+	return b
+}
+
+// WithVolume adds a new volume of the given size in GB and type
+func (b *CreateInstanceBuilder) WithVolume(name string, size int64, volumeType string) *CreateInstanceBuilder {
+	b.input.Volumes = append(b.input.Volumes, Volume{Name: name, Size: size, Type: volumeType})
+	return b
+}
+
+// WithExistingVolumes attaches existing volumes to the instance
+func (b *CreateInstanceBuilder) WithExistingVolumes(ids ...string) *CreateInstanceBuilder {
+	b.input.ExistingVolumes = append(b.input.ExistingVolumes, ids...)
+	return b
+}
+
+// WithSpot sets whether the instance is a spot instance
+func (b *CreateInstanceBuilder) WithSpot(isSpot bool) *CreateInstanceBuilder {
+	b.input.IsSpot = isSpot
+	return b
+}
+
+// WithContract sets the contract type, one of the Contract constants
+func (b *CreateInstanceBuilder) WithContract(contract ContractType) *CreateInstanceBuilder {
+	b.input.Contract = contract
+	return b
+}
+
+// WithPricing sets the pricing type, one of the Pricing constants
+func (b *CreateInstanceBuilder) WithPricing(pricing PricingType) *CreateInstanceBuilder {
+	b.input.Pricing = pricing
+	return b
+}
+
+// WithCatalog makes Build cross-check the instance type and location against
+// the ones listed by the API.
+func (b *CreateInstanceBuilder) WithCatalog(instanceTypes InstanceTypeLister, locations LocationLister) *CreateInstanceBuilder {
+	b.instanceTypes = instanceTypes
+	b.locations = locations
+	return b
+}
+
+// Validate checks the input locally, returning a dcerr.BatchedErrors holding
+// every problem found, or nil if the input is valid.
+func (b *CreateInstanceBuilder) Validate() error {
+	return newValidationError(b.validate())
+}
+
+// Build validates the input and returns it. See BuildWithContext.
+func (b *CreateInstanceBuilder) Build() (*CreateInstanceInput, error) {
+	return b.BuildWithContext(context.Background())
+}
+
+// BuildWithContext validates the input and returns it. If a catalog was set
+// with WithCatalog, the instance type and location are also checked against
+// the API. All validation problems are reported in a single
+// dcerr.BatchedErrors with the request.ErrCodeInvalidParameter code. Errors
+// listing the catalog are returned as is.
+func (b *CreateInstanceBuilder) BuildWithContext(ctx context.Context) (*CreateInstanceInput, error) {
+	errs := b.validate()
+
+	if b.instanceTypes != nil && b.input.InstanceType != "" {
+		types, err := b.instanceTypes.ListInstanceTypesWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !hasInstanceType(types, b.input.InstanceType) {
+			errs = append(errs, invalidParam("InstanceType %q is not an available instance type", b.input.InstanceType))
+		}
+	}
+
+	if b.locations != nil && b.input.LocationCode != "" {
+		locs, err := b.locations.ListLocationsWithContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if !hasLocation(locs, b.input.LocationCode) {
+			errs = append(errs, invalidParam("LocationCode %q is not an available location", b.input.LocationCode))
+		}
+	}
+
+	if err := newValidationError(errs); err != nil {
+		return nil, err
+	}
+
+	input := b.input
+	return &input, nil
+}
+
+// validate returns the problems found in the input without calling the API.
+func (b *CreateInstanceBuilder) validate() []error {
+	var errs []error
+	in := b.input
+
+	if in.InstanceType == "" {
+		errs = append(errs, invalidParam("InstanceType is required"))
+	}
+	if in.Image == "" {
+		errs = append(errs, invalidParam("Image is required"))
+	}
+	if in.LocationCode == "" {
+		errs = append(errs, invalidParam("LocationCode is required"))
+	}
+
+	switch in.Contract {
+	case ContractPayAsYouGo, ContractLongTerm, ContractSpot:
+	default:
+		errs = append(errs, invalidParam("Contract %q must be one of %s, %s or %s",
+			in.Contract, ContractPayAsYouGo, ContractLongTerm, ContractSpot))
+	}
+
+	switch in.Pricing {
+	case PricingFixedPrice, PricingDynamicPrice:
+	default:
+		errs = append(errs, invalidParam("Pricing %q must be one of %s or %s",
+			in.Pricing, PricingFixedPrice, PricingDynamicPrice))
+	}
+
+	if in.IsSpot && in.Contract == ContractLongTerm {
+		errs = append(errs, invalidParam("spot instances cannot use the %s contract", ContractLongTerm))
+	}
+	if !in.IsSpot && in.Contract == ContractSpot {
+		errs = append(errs, invalidParam("the %s contract requires a spot instance", ContractSpot))
+	}
+
+	if in.OSVolume != nil {
+		if in.OSVolume.Size < MinOSVolumeSize {
+			errs = append(errs, invalidParam("OSVolume size must be at least %d GB, got %d", MinOSVolumeSize, in.OSVolume.Size))
+		}
+	}
+	for i, v := range in.Volumes {
+		if v.Name == "" {
+			errs = append(errs, invalidParam("Volumes[%d] name is required", i))
+		}
+		if v.Size <= 0 {
+			errs = append(errs, invalidParam("Volumes[%d] size must be positive, got %d", i, v.Size))
+		}
+	}
+
+	return errs
+}
+
+// invalidParam returns an error describing a single invalid parameter.
+func invalidParam(format string, args ...interface{}) error {
+	return dcerr.New(request.ErrCodeInvalidParameter, fmt.Sprintf(format, args...), nil)
+}
+
+// newValidationError batches validation problems into a single error, or
+// returns nil if there are none.
+func newValidationError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return dcerr.NewBatchError(request.ErrCodeInvalidParameter,
+		fmt.Sprintf("%d validation error(s) found.", len(errs)), errs)
+}
+
+func hasInstanceType(types []*instancetypes.InstanceTypeResponse, instanceType string) bool {
+	for _, t := range types {
+		if t != nil && t.InstanceType == instanceType {
+			return true
+		}
+	}
+	return false
+}
+
+func hasLocation(locs []*locations.LocationResponse, code string) bool {
+	for _, l := range locs {
+		if l != nil && l.Code == code {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,163 @@
+package instance
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/instancetypes"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/locations"
+)
+
+type fakeCatalog struct {
+	types []*instancetypes.InstanceTypeResponse
+	locs  []*locations.LocationResponse
+	err   error
+}
+
+func (f *fakeCatalog) ListInstanceTypesWithContext(context.Context, ...request.Option) ([]*instancetypes.InstanceTypeResponse, error) {
+	return f.types, f.err
+}
+
+func (f *fakeCatalog) ListLocationsWithContext(context.Context, ...request.Option) ([]*locations.LocationResponse, error) {
+	return f.locs, f.err
+}
+
+// validationErrors returns the messages of the errors batched in err.
+func validationErrors(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+	batch, ok := err.(dcerr.BatchedErrors)
+	if !ok || batch.Code() != request.ErrCodeInvalidParameter {
+		t.Fatalf("expected a batched %s error, got %v", request.ErrCodeInvalidParameter, err)
+	}
+	var msgs []string
+	for _, e := range batch.OrigErrs() {
+		msgs = append(msgs, e.(dcerr.Error).Message())
+	}
+	return msgs
+}
+
+func TestCreateInstanceBuilder_Validate(t *testing.T) {
+	valid := func() *CreateInstanceBuilder {
+		return NewCreateInstanceBuilder("1V100.6V", "ubuntu-22.04", "FIN-01")
+	}
+
+	tests := []struct {
+		name     string
+		builder  *CreateInstanceBuilder
+		expected []string
+	}{
+		{name: "valid", builder: valid().WithOSVolume("os", 100).WithVolume("data", 500, "NVMe")},
+		{name: "missing instance type", builder: NewCreateInstanceBuilder("", "ubuntu-22.04", "FIN-01"),
+			expected: []string{"InstanceType is required"}},
+		{name: "missing image", builder: NewCreateInstanceBuilder("1V100.6V", "", "FIN-01"),
+			expected: []string{"Image is required"}},
+		{name: "missing location", builder: NewCreateInstanceBuilder("1V100.6V", "ubuntu-22.04", ""),
+			expected: []string{"LocationCode is required"}},
+		{name: "unknown contract", builder: valid().WithContract("MONTHLY"),
+			expected: []string{`Contract "MONTHLY" must be one of`}},
+		{name: "unknown pricing", builder: valid().WithPricing("FREE"),
+			expected: []string{`Pricing "FREE" must be one of`}},
+		{name: "spot with long term contract", builder: valid().WithSpot(true).WithContract(ContractLongTerm),
+			expected: []string{"spot instances cannot use the LONG_TERM contract"}},
+		{name: "spot contract without spot", builder: valid().WithContract(ContractSpot),
+			expected: []string{"the SPOT contract requires a spot instance"}},
+		{name: "spot with spot contract", builder: valid().WithSpot(true).WithContract(ContractSpot)},
+		{name: "OS volume below minimum", builder: valid().WithOSVolume("os", MinOSVolumeSize-1),
+			expected: []string{"OSVolume size must be at least"}},
+		{name: "OS volume at minimum", builder: valid().WithOSVolume("os", MinOSVolumeSize)},
+		{name: "unnamed volume", builder: valid().WithVolume("", 100, "NVMe"),
+			expected: []string{"Volumes[0] name is required"}},
+		{name: "empty volume", builder: valid().WithVolume("data", 0, "NVMe"),
+			expected: []string{"Volumes[0] size must be positive"}},
+		{
+			name:    "aggregated errors",
+			builder: NewCreateInstanceBuilder("", "", "").WithPricing("FREE").WithVolume("", -1, "NVMe"),
+			expected: []string{
+				"InstanceType is required",
+				"Image is required",
+				"LocationCode is required",
+				`Pricing "FREE" must be one of`,
+				"Volumes[0] name is required",
+				"Volumes[0] size must be positive",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs := validationErrors(t, tt.builder.Validate())
+			if len(msgs) != len(tt.expected) {
+				t.Fatalf("expected %d errors, got %d: %q", len(tt.expected), len(msgs), msgs)
+			}
+			for i, expected := range tt.expected {
+				if !strings.HasPrefix(msgs[i], expected) {
+					t.Errorf("error %d: expected %q, got %q", i, expected, msgs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCreateInstanceBuilder_BuildWithCatalog(t *testing.T) {
+	catalog := &fakeCatalog{
+		types: []*instancetypes.InstanceTypeResponse{{InstanceType: "1V100.6V"}},
+		locs:  []*locations.LocationResponse{{Code: "FIN-01"}},
+	}
+
+	tests := []struct {
+		name         string
+		instanceType string
+		location     string
+		expected     []string
+	}{
+		{name: "listed", instanceType: "1V100.6V", location: "FIN-01"},
+		{name: "unknown instance type", instanceType: "8H200.80S", location: "FIN-01",
+			expected: []string{`InstanceType "8H200.80S" is not an available instance type`}},
+		{name: "unknown location", instanceType: "1V100.6V", location: "ICE-01",
+			expected: []string{`LocationCode "ICE-01" is not an available location`}},
+		{name: "local and catalog errors", instanceType: "8H200.80S", location: "",
+			expected: []string{
+				"LocationCode is required",
+				`InstanceType "8H200.80S" is not an available instance type`,
+			}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, err := NewCreateInstanceBuilder(tt.instanceType, "ubuntu-22.04", tt.location).
+				WithCatalog(catalog, catalog).
+				Build()
+			msgs := validationErrors(t, err)
+			if len(msgs) != len(tt.expected) {
+				t.Fatalf("expected %d errors, got %d: %q", len(tt.expected), len(msgs), msgs)
+			}
+			for i, expected := range tt.expected {
+				if msgs[i] != expected {
+					t.Errorf("error %d: expected %q, got %q", i, expected, msgs[i])
+				}
+			}
+			if err == nil && (input.InstanceType != tt.instanceType || input.Contract != ContractPayAsYouGo) {
+				t.Errorf("unexpected input %+v", input)
+			}
+		})
+	}
+}
+
+func TestCreateInstanceBuilder_CatalogError(t *testing.T) {
+	listErr := errors.New("catalog unavailable")
+	catalog := &fakeCatalog{err: listErr}
+
+	_, err := NewCreateInstanceBuilder("1V100.6V", "ubuntu-22.04", "FIN-01").
+		WithCatalog(catalog, catalog).
+		Build()
+	if err != listErr {
+		t.Fatalf("expected the catalog error, got %v", err)
+	}
+}
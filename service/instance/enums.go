@@ -0,0 +1,63 @@
+package instance
+
+import (
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/util"
+)
+
+// ContractType is the contract an instance is billed under
+type ContractType string
+
+const (
+	ContractPayAsYouGo ContractType = "PAY_AS_YOU_GO"
+	ContractLongTerm   ContractType = "LONG_TERM"
+	ContractSpot       ContractType = "SPOT"
+)
+
+// UnmarshalJSON decodes the contract type, keeping values unknown to the SDK
+// as is.
+func (t *ContractType) UnmarshalJSON(data []byte) error {
+	*t = ContractType(util.DecodeEnum(data))
+	return nil
+}
+
+// PricingType is how the hourly price of an instance is set
+type PricingType string
+
+const (
+	PricingFixedPrice   PricingType = "FIXED_PRICE"
+	PricingDynamicPrice PricingType = "DYNAMIC_PRICE"
+)
+
+// UnmarshalJSON decodes the pricing type, keeping values unknown to the SDK
+// as is.
+func (t *PricingType) UnmarshalJSON(data []byte) error {
+	*t = PricingType(util.DecodeEnum(data))
+	return nil
+}
+
+// UnmarshalJSON decodes the instance status, keeping values unknown to the
+// SDK as is.
+func (s *InstanceStatus) UnmarshalJSON(data []byte) error {
+	*s = InstanceStatus(util.DecodeEnum(data))
+	return nil
+}
+
+// IsTerminal returns true if the instance will not change status anymore.
+func (s InstanceStatus) IsTerminal() bool {
+	switch s {
+	case InstanceStatusDiscontinued, InstanceStatusNotFound, InstanceStatusError:
+		return true
+	}
+	return false
+}
+
+// IsTransitional returns true if the instance is on its way to another
+// status, such as provisioning or deleting.
+func (s InstanceStatus) IsTransitional() bool {
+	switch s {
+	case InstanceStatusNew, InstanceStatusOrdered, InstanceStatusValidating,
+		InstanceStatusProvisioning, InstanceStatusDeleting:
+		return true
+	}
+	return false
+}
@@ -1,6 +1,10 @@
 package interfaces
 
 import (
+	"context"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/instance"
 )
 
@@ -8,10 +12,48 @@ import (
 type InstanceAPI interface {
 	// ListInstances lists all instances
 	ListInstances(input *instance.ListInstancesInput) ([]*instance.ListInstancesResponse, error)
+	ListInstancesWithContext(ctx context.Context, input *instance.ListInstancesInput, opts ...request.Option) ([]*instance.ListInstancesResponse, error)
+	// GetInstance gets an instance by ID
+	GetInstance(id string) (*instance.ListInstancesResponse, error)
+	GetInstanceWithContext(ctx context.Context, id string, opts ...request.Option) (*instance.ListInstancesResponse, error)
 	// CreateInstance creates a new instance
 	CreateInstance(input *instance.CreateInstanceInput) (string, error)
+	CreateInstanceWithContext(ctx context.Context, input *instance.CreateInstanceInput, opts ...request.Option) (string, error)
 	// PerformInstanceAction performs an action on an instance
 	PerformInstanceAction(input *instance.InstanceActionInput) error
+	PerformInstanceActionWithContext(ctx context.Context, input *instance.InstanceActionInput, opts ...request.Option) error
+	// PerformInstanceActions performs an action on many instances
+	PerformInstanceActions(input *instance.BatchInstanceActionInput) (map[string]error, error)
+	PerformInstanceActionsWithContext(ctx context.Context, input *instance.BatchInstanceActionInput, opts ...request.Option) (map[string]error, error)
+	// StartInstance starts an offline instance
+	StartInstance(id string) error
+	StartInstanceWithContext(ctx context.Context, id string, opts ...request.Option) error
+	// ShutdownInstance shuts down a running instance
+	ShutdownInstance(id string) error
+	ShutdownInstanceWithContext(ctx context.Context, id string, opts ...request.Option) error
+	// HibernateInstance hibernates an instance
+	HibernateInstance(id string) error
+	HibernateInstanceWithContext(ctx context.Context, id string, opts ...request.Option) error
+	// DeleteInstance deletes an instance and the given volumes
+	DeleteInstance(id string, volumeIDs []string) error
+	DeleteInstanceWithContext(ctx context.Context, id string, volumeIDs []string, opts ...request.Option) error
+	// ConfigureSpot configures how a spot instance is discontinued
+	ConfigureSpot(input *instance.ConfigureSpotInput) error
+	ConfigureSpotWithContext(ctx context.Context, input *instance.ConfigureSpotInput, opts ...request.Option) error
+	// WatchInstances reports instances created, deleted or changing status
+	WatchInstances(ctx context.Context, input *instance.WatchInstancesInput) <-chan *instance.InstanceEvent
+	WatchInstancesFunc(ctx context.Context, input *instance.WatchInstancesInput, fn func(*instance.InstanceEvent)) error
+	// WatchSpotPreemptions reports spot instances that stop running
+	WatchSpotPreemptions(ctx context.Context, interval time.Duration) <-chan *instance.SpotPreemptionEvent
+	// WaitUntilInstanceRunning waits until an instance is running
+	WaitUntilInstanceRunning(id string) error
+	WaitUntilInstanceRunningWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error
+	// WaitUntilInstanceOffline waits until an instance is offline
+	WaitUntilInstanceOffline(id string) error
+	WaitUntilInstanceOfflineWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error
+	// WaitUntilInstanceDeleted waits until an instance is deleted
+	WaitUntilInstanceDeleted(id string) error
+	WaitUntilInstanceDeletedWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error
 }
 
 var _ InstanceAPI = (*instance.Instance)(nil)
@@ -118,7 +118,7 @@ func TestCreateAndDeleteInstance_Integration(t *testing.T) {
 			}
 
 			t.Logf("Instance %s status: %s", instanceID, foundInstance.Status)
-			if foundInstance.Status == string(instance.InstanceStatusRunning) {
+			if foundInstance.Status == instance.InstanceStatusRunning {
 				t.Logf("Instance %s is still running, stopping it...", instanceID)
 				err = instanceClient.PerformInstanceAction(&instance.InstanceActionInput{
 					Action: instance.InstanceActionForceShutdown,
@@ -128,7 +128,7 @@ func TestCreateAndDeleteInstance_Integration(t *testing.T) {
 					t.Fatalf("failed to stop instance %s: %v", instanceID, err)
 				}
 			}
-			if foundInstance.Status == string(instance.InstanceStatusOffline) {
+			if foundInstance.Status == instance.InstanceStatusOffline {
 				t.Logf("Instance %s can now be deleted", instanceID)
 				break
 			}
@@ -178,6 +178,15 @@ func TestCreateAndDeleteInstance_Integration(t *testing.T) {
 	if !found {
 		t.Errorf("created instance %s not found in list", instanceID)
 	}
+
+	// Verify instance can be fetched by ID
+	inst, err := instanceClient.GetInstance(instanceID)
+	if err != nil {
+		t.Fatalf("failed to get instance %s: %v", instanceID, err)
+	}
+	if inst.ID != instanceID {
+		t.Errorf("expected instance ID %s, got %s", instanceID, inst.ID)
+	}
 }
 
 func TestListInstances_Integration(t *testing.T) {
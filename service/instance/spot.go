@@ -0,0 +1,119 @@
+package instance
+
+import (
+	"context"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+// SpotDiscontinuePolicy is what happens to the volumes of a spot instance
+// when it is discontinued
+type SpotDiscontinuePolicy string
+
+const (
+	SpotDiscontinueKeepDetached      SpotDiscontinuePolicy = "keep_detached"
+	SpotDiscontinueMoveToTrash       SpotDiscontinuePolicy = "move_to_trash"
+	SpotDiscontinueDeletePermanently SpotDiscontinuePolicy = "delete_permanently"
+)
+
+// ConfigureSpotInput represents the input for configuring a spot instance
+type ConfigureSpotInput struct {
+	ID string
+
+	// VolumeIDs are the volumes the policy applies to
+	VolumeIDs []string
+
+	// OnSpotDiscontinue is what happens to the volumes when the spot
+	// instance is discontinued
+	OnSpotDiscontinue SpotDiscontinuePolicy
+}
+
+// ConfigureSpot configures how a spot instance is discontinued. An error with
+// the request.ErrCodeInvalidParameter code is returned if no ID is given.
+func (c *Instance) ConfigureSpot(input *ConfigureSpotInput) error {
+	return c.ConfigureSpotWithContext(context.Background(), input)
+}
+
+// ConfigureSpotWithContext is the same as ConfigureSpot with the addition of
+// the ability to pass a context and additional request options.
+func (c *Instance) ConfigureSpotWithContext(ctx context.Context, input *ConfigureSpotInput, opts ...request.Option) error {
+	if input == nil || input.ID == "" {
+		return dcerr.New(request.ErrCodeInvalidParameter, "an instance ID is required", nil)
+	}
+	return c.PerformInstanceActionWithContext(ctx, &InstanceActionInput{
+		Action:            InstanceActionConfigureSpot,
+		ID:                input.ID,
+		VolumeIDs:         input.VolumeIDs,
+		OnSpotDiscontinue: input.OnSpotDiscontinue,
+	}, opts...)
+}
+
+// SpotPreemptionEvent reports a spot instance that left the running state.
+type SpotPreemptionEvent struct {
+	// Instance is the last state of the instance seen running
+	Instance *ListInstancesResponse
+
+	// Status is the status of the instance after it stopped running,
+	// InstanceStatusNotFound if it is no longer listed
+	Status InstanceStatus
+
+	// Time is when the change was observed
+	Time time.Time
+}
+
+// WatchSpotPreemptions polls ListInstances every interval and sends an event
+// on the returned channel whenever a spot instance previously seen running
+// is no longer running. Spot instances shut down or deleted by the caller are
+// reported too, as the API does not tell them apart from reclaimed ones.
+//
+// Polling stops and the channel is closed once ctx is done. Failed polls are
+// logged and retried with a backoff, as with WatchInstances.
+func (c *Instance) WatchSpotPreemptions(ctx context.Context, interval time.Duration) <-chan *SpotPreemptionEvent {
+	events := make(chan *SpotPreemptionEvent)
+	go func() {
+		defer close(events)
+
+		input := &WatchInstancesInput{
+			Interval: interval,
+			Filter: func(inst *ListInstancesResponse) bool {
+				return inst.IsSpot
+			},
+		}
+		_ = c.WatchInstancesFunc(ctx, input, func(event *InstanceEvent) {
+			preemption := spotPreemption(event)
+			if preemption == nil {
+				return
+			}
+			select {
+			case events <- preemption:
+			case <-ctx.Done():
+			}
+		})
+	}()
+
+	return events
+}
+
+// spotPreemption returns the preemption reported by an instance event, nil
+// if the instance was not running before or still is.
+func spotPreemption(event *InstanceEvent) *SpotPreemptionEvent {
+	if event.Previous == nil || event.Previous.Status != InstanceStatusRunning {
+		return nil
+	}
+
+	status := InstanceStatusNotFound
+	if event.Instance != nil {
+		status = event.Instance.Status
+	}
+	if status == InstanceStatusRunning {
+		return nil
+	}
+
+	return &SpotPreemptionEvent{
+		Instance: event.Previous,
+		Status:   status,
+		Time:     event.Time,
+	}
+}
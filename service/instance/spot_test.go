@@ -0,0 +1,112 @@
+package instance
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+func TestSpotPreemption(t *testing.T) {
+	now := time.Now()
+	running := &ListInstancesResponse{ID: "i-1", IsSpot: true, Status: InstanceStatusRunning}
+	withStatus := func(status InstanceStatus) *ListInstancesResponse {
+		return &ListInstancesResponse{ID: "i-1", IsSpot: true, Status: status}
+	}
+
+	tests := []struct {
+		name     string
+		event    *InstanceEvent
+		expected *SpotPreemptionEvent
+	}{
+		{
+			name:     "discontinued",
+			event:    &InstanceEvent{Type: InstanceEventStatusChanged, ID: "i-1", Previous: running, Instance: withStatus(InstanceStatusDiscontinued), Time: now},
+			expected: &SpotPreemptionEvent{Instance: running, Status: InstanceStatusDiscontinued, Time: now},
+		},
+		{
+			name:     "offline",
+			event:    &InstanceEvent{Type: InstanceEventStatusChanged, ID: "i-1", Previous: running, Instance: withStatus(InstanceStatusOffline), Time: now},
+			expected: &SpotPreemptionEvent{Instance: running, Status: InstanceStatusOffline, Time: now},
+		},
+		{
+			name:     "deleted",
+			event:    &InstanceEvent{Type: InstanceEventDeleted, ID: "i-1", Previous: running, Time: now},
+			expected: &SpotPreemptionEvent{Instance: running, Status: InstanceStatusNotFound, Time: now},
+		},
+		{
+			name:  "created",
+			event: &InstanceEvent{Type: InstanceEventCreated, ID: "i-1", Instance: running, Time: now},
+		},
+		{
+			name:  "not running before",
+			event: &InstanceEvent{Type: InstanceEventStatusChanged, ID: "i-1", Previous: withStatus(InstanceStatusProvisioning), Instance: running, Time: now},
+		},
+		{
+			name:  "deleted while not running",
+			event: &InstanceEvent{Type: InstanceEventDeleted, ID: "i-1", Previous: withStatus(InstanceStatusOffline), Time: now},
+		},
+		{
+			name:  "still running",
+			event: &InstanceEvent{Type: InstanceEventStatusChanged, ID: "i-1", Previous: running, Instance: withStatus(InstanceStatusRunning), Time: now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := spotPreemption(tt.event)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestConfigureSpot(t *testing.T) {
+	var body map[string]interface{}
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/instances" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode body: %v", err)
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	err := c.ConfigureSpot(&ConfigureSpotInput{
+		ID:                "i-1",
+		VolumeIDs:         []string{"v-1", "v-2"},
+		OnSpotDiscontinue: SpotDiscontinueMoveToTrash,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := map[string]interface{}{
+		"action":              "configure_spot",
+		"id":                  "i-1",
+		"volume_ids":          []interface{}{"v-1", "v-2"},
+		"on_spot_discontinue": "move_to_trash",
+	}
+	if !reflect.DeepEqual(body, expected) {
+		t.Errorf("expected body %v, got %v", expected, body)
+	}
+}
+
+func TestConfigureSpot_InvalidInput(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	for _, input := range []*ConfigureSpotInput{nil, {OnSpotDiscontinue: SpotDiscontinueKeepDetached}} {
+		err := c.ConfigureSpot(input)
+		if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != request.ErrCodeInvalidParameter {
+			t.Errorf("%+v: expected %s error, got %v", input, request.ErrCodeInvalidParameter, err)
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package instance
+
+import (
+	"context"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+const (
+	// instanceWaiterDelay is the default delay between instance status checks
+	instanceWaiterDelay = 10 * time.Second
+
+	// instanceWaiterMaxAttempts is the default number of instance status
+	// checks made before a waiter gives up
+	instanceWaiterMaxAttempts = 60
+)
+
+// WaitUntilInstanceRunning uses the ListInstances API operation to wait until
+// the instance is running. An error is returned if the instance fails to
+// provision, is deleted, or does not become running within the maximum number
+// of attempts.
+func (c *Instance) WaitUntilInstanceRunning(id string) error {
+	return c.WaitUntilInstanceRunningWithContext(context.Background(), id)
+}
+
+// WaitUntilInstanceRunningWithContext is an extended version of
+// WaitUntilInstanceRunning with support for passing a context and options to
+// configure the Waiter and the underlying request options.
+func (c *Instance) WaitUntilInstanceRunningWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error {
+	return c.waitForInstanceStatus(ctx, "WaitUntilInstanceRunning", id, []request.WaiterAcceptor{
+		instanceStatusAcceptor(request.SuccessWaiterState, id, InstanceStatusRunning),
+		instanceStatusAcceptor(request.FailureWaiterState, id, InstanceStatusError),
+		instanceStatusAcceptor(request.FailureWaiterState, id, InstanceStatusDeleting),
+		instanceStatusAcceptor(request.FailureWaiterState, id, InstanceStatusDiscontinued),
+	}, opts...)
+}
+
+// WaitUntilInstanceOffline uses the ListInstances API operation to wait until
+// the instance is offline. An error is returned if the instance fails, is
+// deleted, or does not go offline within the maximum number of attempts.
+func (c *Instance) WaitUntilInstanceOffline(id string) error {
+	return c.WaitUntilInstanceOfflineWithContext(context.Background(), id)
+}
+
+// WaitUntilInstanceOfflineWithContext is an extended version of
+// WaitUntilInstanceOffline with support for passing a context and options to
+// configure the Waiter and the underlying request options.
+func (c *Instance) WaitUntilInstanceOfflineWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error {
+	return c.waitForInstanceStatus(ctx, "WaitUntilInstanceOffline", id, []request.WaiterAcceptor{
+		instanceStatusAcceptor(request.SuccessWaiterState, id, InstanceStatusOffline),
+		instanceStatusAcceptor(request.FailureWaiterState, id, InstanceStatusError),
+		instanceStatusAcceptor(request.FailureWaiterState, id, InstanceStatusDeleting),
+		instanceStatusAcceptor(request.FailureWaiterState, id, InstanceStatusDiscontinued),
+		instanceStatusAcceptor(request.FailureWaiterState, id, InstanceStatusNotFound),
+	}, opts...)
+}
+
+// WaitUntilInstanceDeleted uses the ListInstances API operation to wait until
+// the instance is discontinued or no longer listed. An error is returned if
+// the instance fails or is not deleted within the maximum number of attempts.
+func (c *Instance) WaitUntilInstanceDeleted(id string) error {
+	return c.WaitUntilInstanceDeletedWithContext(context.Background(), id)
+}
+
+// WaitUntilInstanceDeletedWithContext is an extended version of
+// WaitUntilInstanceDeleted with support for passing a context and options to
+// configure the Waiter and the underlying request options.
+func (c *Instance) WaitUntilInstanceDeletedWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error {
+	return c.waitForInstanceStatus(ctx, "WaitUntilInstanceDeleted", id, []request.WaiterAcceptor{
+		instanceStatusAcceptor(request.SuccessWaiterState, id, InstanceStatusDiscontinued),
+		instanceStatusAcceptor(request.SuccessWaiterState, id, InstanceStatusNotFound),
+		instanceStatusAcceptor(request.FailureWaiterState, id, InstanceStatusError),
+	}, opts...)
+}
+
+// waitForInstanceStatus polls the instance list until one of the acceptors
+// matches the status of the instance.
+func (c *Instance) waitForInstanceStatus(ctx context.Context, name, id string, acceptors []request.WaiterAcceptor, opts ...request.WaiterOption) error {
+	w := request.Waiter{
+		Name:        name,
+		MaxAttempts: instanceWaiterMaxAttempts,
+		Delay:       request.ConstantWaiterDelay(instanceWaiterDelay),
+		Acceptors:   acceptors,
+		NewRequest: func(opts []request.Option) (*request.Request, error) {
+			req, _ := c.listInstancesRequest(nil)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+
+	return w.WaitWithContext(ctx)
+}
+
+// instanceStatusAcceptor returns an acceptor matching the status of the
+// instance with the given ID in a ListInstances response.
+func instanceStatusAcceptor(state request.WaiterState, id string, status InstanceStatus) request.WaiterAcceptor {
+	return request.WaiterAcceptor{
+		State:    state,
+		Matcher:  request.ValueWaiterMatch,
+		Expected: status,
+		Argument: func(data interface{}) interface{} {
+			return instanceStatusFromList(data, id)
+		},
+	}
+}
+
+// instanceStatusFromList returns the status of the instance with the given ID,
+// or InstanceStatusNotFound if it is not listed.
+func instanceStatusFromList(data interface{}, id string) InstanceStatus {
+	instances, ok := data.(*[]*ListInstancesResponse)
+	if !ok || instances == nil {
+		return InstanceStatusUnknown
+	}
+	for _, inst := range *instances {
+		if inst != nil && inst.ID == id {
+			return inst.Status
+		}
+	}
+	return InstanceStatusNotFound
+}
@@ -0,0 +1,77 @@
+package instance
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+func TestInstanceWaiters(t *testing.T) {
+	type waitFunc func(c *Instance, ctx context.Context, id string, opts ...request.WaiterOption) error
+	running := (*Instance).WaitUntilInstanceRunningWithContext
+	offline := (*Instance).WaitUntilInstanceOfflineWithContext
+	deleted := (*Instance).WaitUntilInstanceDeletedWithContext
+
+	// An empty status means the instance is not listed
+	tests := []struct {
+		name     string
+		wait     waitFunc
+		statuses []InstanceStatus
+		success  bool
+		attempts int32
+	}{
+		{name: "running after provisioning", wait: running, statuses: []InstanceStatus{InstanceStatusOrdered, InstanceStatusProvisioning, InstanceStatusRunning}, success: true, attempts: 3},
+		{name: "running fails on error", wait: running, statuses: []InstanceStatus{InstanceStatusProvisioning, InstanceStatusError}, attempts: 2},
+		{name: "running fails on deleting", wait: running, statuses: []InstanceStatus{InstanceStatusDeleting}, attempts: 1},
+		{name: "running fails on discontinued", wait: running, statuses: []InstanceStatus{InstanceStatusDiscontinued}, attempts: 1},
+		{name: "running retries until max attempts", wait: running, statuses: []InstanceStatus{InstanceStatusProvisioning}, attempts: 3},
+		{name: "running retries while not listed", wait: running, statuses: []InstanceStatus{"", InstanceStatusRunning}, success: true, attempts: 2},
+		{name: "offline after running", wait: offline, statuses: []InstanceStatus{InstanceStatusRunning, InstanceStatusOffline}, success: true, attempts: 2},
+		{name: "offline fails on error", wait: offline, statuses: []InstanceStatus{InstanceStatusError}, attempts: 1},
+		{name: "offline fails when not listed", wait: offline, statuses: []InstanceStatus{""}, attempts: 1},
+		{name: "deleted when discontinued", wait: deleted, statuses: []InstanceStatus{InstanceStatusDeleting, InstanceStatusDiscontinued}, success: true, attempts: 2},
+		{name: "deleted when not listed", wait: deleted, statuses: []InstanceStatus{InstanceStatusDeleting, ""}, success: true, attempts: 2},
+		{name: "deleted fails on error", wait: deleted, statuses: []InstanceStatus{InstanceStatusError}, attempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/instances" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				n := int(atomic.AddInt32(&calls, 1))
+				if n > len(tt.statuses) {
+					n = len(tt.statuses)
+				}
+				instances := []*ListInstancesResponse{{ID: "i-other", Status: InstanceStatusRunning}}
+				if status := tt.statuses[n-1]; status != "" {
+					instances = append(instances, &ListInstancesResponse{ID: "i-1", Status: status})
+				}
+				_ = json.NewEncoder(w).Encode(instances)
+			})
+
+			err := tt.wait(c, context.Background(), "i-1",
+				request.WithWaiterDelay(request.ConstantWaiterDelay(time.Millisecond)),
+				request.WithWaiterMaxAttempts(3))
+			if tt.success && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.success {
+				if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != request.WaiterResourceNotReadyErrorCode {
+					t.Fatalf("expected %s error, got %v", request.WaiterResourceNotReadyErrorCode, err)
+				}
+			}
+			if got := atomic.LoadInt32(&calls); got != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, got)
+			}
+		})
+	}
+}
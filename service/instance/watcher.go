@@ -0,0 +1,198 @@
+package instance
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
+)
+
+const (
+	// DefaultWatchInterval is the delay between instance list polls used by
+	// the watchers when no interval is given.
+	DefaultWatchInterval = 30 * time.Second
+
+	// DefaultWatchMaxBackoff caps the delay between polls after consecutive
+	// list errors when no maximum is given.
+	DefaultWatchMaxBackoff = 5 * time.Minute
+)
+
+// InstanceEventType is the kind of change reported by an InstanceEvent
+type InstanceEventType string
+
+const (
+	InstanceEventCreated       InstanceEventType = "created"
+	InstanceEventStatusChanged InstanceEventType = "status_changed"
+	InstanceEventDeleted       InstanceEventType = "deleted"
+)
+
+// InstanceEvent reports a change between two instance list snapshots.
+type InstanceEvent struct {
+	Type InstanceEventType
+
+	// ID is the ID of the instance that changed
+	ID string
+
+	// Instance is the current state of the instance, nil if it was deleted
+	Instance *ListInstancesResponse
+
+	// Previous is the state of the instance in the previous snapshot, nil if
+	// it was created
+	Previous *ListInstancesResponse
+
+	// Time is when the change was observed
+	Time time.Time
+}
+
+// WatchInstancesInput configures an instance watcher
+type WatchInstancesInput struct {
+	// Interval is the delay between polls, DefaultWatchInterval if zero
+	Interval time.Duration
+
+	// MaxBackoff caps the delay between polls after consecutive list errors,
+	// which doubles from Interval on each error. DefaultWatchMaxBackoff if
+	// zero.
+	MaxBackoff time.Duration
+
+	// Filter restricts the watched instances to those it returns true for.
+	// An instance no longer matching is reported as deleted.
+	Filter func(*ListInstancesResponse) bool
+}
+
+// WatchInstances polls ListInstances and sends an event on the returned
+// channel for every instance created, deleted or whose status changed since
+// the previous poll. The first poll only records the current instances.
+//
+// Polling stops and the channel is closed once ctx is done.
+func (c *Instance) WatchInstances(ctx context.Context, input *WatchInstancesInput) <-chan *InstanceEvent {
+	events := make(chan *InstanceEvent)
+	go func() {
+		defer close(events)
+		_ = c.WatchInstancesFunc(ctx, input, func(event *InstanceEvent) {
+			select {
+			case events <- event:
+			case <-ctx.Done():
+			}
+		})
+	}()
+	return events
+}
+
+// WatchInstancesFunc is the same as WatchInstances but calls fn for each
+// event instead of sending it on a channel. Events are delivered one at a
+// time, ordered by instance ID within a poll. It blocks until ctx is done and
+// returns the context error.
+//
+// Failed polls are logged and retried with an exponential backoff.
+func (c *Instance) WatchInstancesFunc(ctx context.Context, input *WatchInstancesInput, fn func(*InstanceEvent)) error {
+	if input == nil {
+		input = &WatchInstancesInput{}
+	}
+	interval := input.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	maxBackoff := input.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = DefaultWatchMaxBackoff
+	}
+
+	var previous map[string]*ListInstancesResponse
+	failures := 0
+	for {
+		delay := interval
+		instances, err := c.ListInstancesWithContext(ctx, nil)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			failures++
+			delay = watchBackoff(interval, maxBackoff, failures)
+			logger.Debug("WatchInstances: failed to list instances, retrying in %v: %v", delay, err)
+		} else {
+			failures = 0
+			current := instanceSnapshot(instances, input.Filter)
+			if previous != nil {
+				for _, event := range diffInstances(previous, current, time.Now()) {
+					fn(event)
+				}
+			}
+			previous = current
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// watchBackoff returns the delay before the next poll after the given number
+// of consecutive failures.
+func watchBackoff(interval, maxBackoff time.Duration, failures int) time.Duration {
+	delay := interval
+	for i := 1; i < failures && delay < maxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > maxBackoff {
+		delay = maxBackoff
+	}
+	return delay
+}
+
+// instanceSnapshot indexes the instances matching filter by ID.
+func instanceSnapshot(instances []*ListInstancesResponse, filter func(*ListInstancesResponse) bool) map[string]*ListInstancesResponse {
+	snapshot := make(map[string]*ListInstancesResponse, len(instances))
+	for _, inst := range instances {
+		if inst == nil || (filter != nil && !filter(inst)) {
+			continue
+		}
+		snapshot[inst.ID] = inst
+	}
+	return snapshot
+}
+
+// diffInstances returns the events turning the previous snapshot into the
+// current one, ordered by instance ID.
+func diffInstances(previous, current map[string]*ListInstancesResponse, now time.Time) []*InstanceEvent {
+	ids := make([]string, 0, len(previous)+len(current))
+	for id := range previous {
+		ids = append(ids, id)
+	}
+	for id := range current {
+		if _, ok := previous[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	var events []*InstanceEvent
+	for _, id := range ids {
+		before, after := previous[id], current[id]
+
+		var eventType InstanceEventType
+		switch {
+		case before == nil:
+			eventType = InstanceEventCreated
+		case after == nil:
+			eventType = InstanceEventDeleted
+		case before.Status != after.Status:
+			eventType = InstanceEventStatusChanged
+		default:
+			continue
+		}
+
+		events = append(events, &InstanceEvent{
+			Type:     eventType,
+			ID:       id,
+			Instance: after,
+			Previous: before,
+			Time:     now,
+		})
+	}
+	return events
+}
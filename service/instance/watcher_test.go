@@ -0,0 +1,111 @@
+package instance
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDiffInstances(t *testing.T) {
+	now := time.Now()
+	instance := func(id string, status InstanceStatus) *ListInstancesResponse {
+		return &ListInstancesResponse{ID: id, Status: status}
+	}
+	snapshot := func(instances ...*ListInstancesResponse) map[string]*ListInstancesResponse {
+		return instanceSnapshot(instances, nil)
+	}
+
+	type event struct {
+		Type InstanceEventType
+		ID   string
+	}
+	tests := []struct {
+		name     string
+		previous map[string]*ListInstancesResponse
+		current  map[string]*ListInstancesResponse
+		expected []event
+	}{
+		{
+			name:     "created",
+			previous: snapshot(instance("i-1", InstanceStatusRunning)),
+			current:  snapshot(instance("i-1", InstanceStatusRunning), instance("i-2", InstanceStatusNew)),
+			expected: []event{{InstanceEventCreated, "i-2"}},
+		},
+		{
+			name:     "status changed",
+			previous: snapshot(instance("i-1", InstanceStatusProvisioning)),
+			current:  snapshot(instance("i-1", InstanceStatusRunning)),
+			expected: []event{{InstanceEventStatusChanged, "i-1"}},
+		},
+		{
+			name:     "deleted",
+			previous: snapshot(instance("i-1", InstanceStatusRunning), instance("i-2", InstanceStatusOffline)),
+			current:  snapshot(instance("i-1", InstanceStatusRunning)),
+			expected: []event{{InstanceEventDeleted, "i-2"}},
+		},
+		{
+			name:     "unchanged",
+			previous: snapshot(instance("i-1", InstanceStatusRunning)),
+			current:  snapshot(&ListInstancesResponse{ID: "i-1", Status: InstanceStatusRunning, Hostname: "renamed"}),
+		},
+		{
+			name:     "ordered by ID",
+			previous: snapshot(instance("i-2", InstanceStatusRunning), instance("i-3", InstanceStatusRunning)),
+			current:  snapshot(instance("i-1", InstanceStatusNew), instance("i-3", InstanceStatusOffline)),
+			expected: []event{{InstanceEventCreated, "i-1"}, {InstanceEventDeleted, "i-2"}, {InstanceEventStatusChanged, "i-3"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := diffInstances(tt.previous, tt.current, now)
+			if len(events) != len(tt.expected) {
+				t.Fatalf("expected %d events, got %d", len(tt.expected), len(events))
+			}
+			for i, e := range events {
+				if e.Type != tt.expected[i].Type || e.ID != tt.expected[i].ID {
+					t.Errorf("event %d: expected %v, got %s %s", i, tt.expected[i], e.Type, e.ID)
+				}
+				if e.Previous != tt.previous[e.ID] || e.Instance != tt.current[e.ID] || !e.Time.Equal(now) {
+					t.Errorf("event %d: unexpected states or time %+v", i, e)
+				}
+			}
+		})
+	}
+}
+
+func TestInstanceSnapshot_Filter(t *testing.T) {
+	snapshot := instanceSnapshot([]*ListInstancesResponse{
+		{ID: "i-1", IsSpot: true},
+		nil,
+		{ID: "i-2"},
+	}, func(inst *ListInstancesResponse) bool { return inst.IsSpot })
+
+	if len(snapshot) != 1 || snapshot["i-1"] == nil {
+		t.Errorf("expected only the spot instance, got %v", snapshot)
+	}
+}
+
+func TestWatchBackoff(t *testing.T) {
+	tests := []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 1, expected: 30 * time.Second},
+		{failures: 2, expected: time.Minute},
+		{failures: 3, expected: 2 * time.Minute},
+		{failures: 4, expected: 4 * time.Minute},
+		{failures: 5, expected: 5 * time.Minute},
+		{failures: 100, expected: 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := watchBackoff(30*time.Second, 5*time.Minute, tt.failures); got != tt.expected {
+			t.Errorf("%d failures: expected %v, got %v", tt.failures, tt.expected, got)
+		}
+	}
+
+	// An interval above the maximum is capped
+	if got := watchBackoff(10*time.Minute, 5*time.Minute, 1); got != 5*time.Minute {
+		t.Errorf("expected the interval to be capped at 5m, got %v", got)
+	}
+}
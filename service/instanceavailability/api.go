@@ -1,6 +1,8 @@
 package instanceavailability
 
 import (
+	"context"
+
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 )
 
@@ -17,6 +19,13 @@ type InstanceAvailabilityResponse struct {
 
 // ListInstanceAvailability lists all available instance types by location
 func (c *InstanceAvailability) ListInstanceAvailability() ([]*InstanceAvailabilityResponse, error) {
+	return c.ListInstanceAvailabilityWithContext(context.Background())
+}
+
+// ListInstanceAvailabilityWithContext is the same as ListInstanceAvailability
+// with the addition of the ability to pass a context and additional request
+// options.
+func (c *InstanceAvailability) ListInstanceAvailabilityWithContext(ctx context.Context, opts ...request.Option) ([]*InstanceAvailabilityResponse, error) {
 	op := &request.Operation{
 		Name:       "ListInstanceAvailability",
 		HTTPMethod: "GET",
@@ -25,35 +34,53 @@ func (c *InstanceAvailability) ListInstanceAvailability() ([]*InstanceAvailabili
 
 	var availabilities []*InstanceAvailabilityResponse
 	req := c.newRequest(op, nil, &availabilities)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return availabilities, req.Send()
 }
 
+// CheckInstanceAvailabilityInput represents the input for checking the
+// availability of an instance type
+type CheckInstanceAvailabilityInput struct {
+	InstanceType string  `location:"uri" locationName:"instance_type"`
+	LocationCode *string `location:"querystring" locationName:"location_code"`
+	IsSpot       *bool   `location:"querystring" locationName:"is_spot"`
+}
+
 // CheckInstanceAvailability checks if a specific instance type is available
-// DISABLED: This function is disabled due to inconsistent API behavior.
-// The API returns different response formats based on query parameters:
-// - With insufficient params: returns array
-// - With instance type: returns boolean as string ("true"/"false")
-// This inconsistency makes it difficult to implement reliably.
-/*
-func (c *InstanceAvailability) CheckInstanceAvailability(instanceType string, locationCode *string, isSpot *bool) (bool, error) {
+func (c *InstanceAvailability) CheckInstanceAvailability(input *CheckInstanceAvailabilityInput) (bool, error) {
+	return c.CheckInstanceAvailabilityWithContext(context.Background(), input)
+}
+
+// CheckInstanceAvailabilityWithContext is the same as
+// CheckInstanceAvailability with the addition of the ability to pass a context
+// and additional request options.
+func (c *InstanceAvailability) CheckInstanceAvailabilityWithContext(ctx context.Context, input *CheckInstanceAvailabilityInput, opts ...request.Option) (bool, error) {
 	op := &request.Operation{
 		Name:       "CheckInstanceAvailability",
 		HTTPMethod: "GET",
-		HTTPPath:   fmt.Sprintf("/instance-availability/%s", instanceType),
+		HTTPPath:   "/instance-availability/{instance_type}",
 	}
 
-	params := map[string]interface{}{}
-	if locationCode != nil {
-		params["location_code"] = *locationCode
-	}
-	if isSpot != nil {
-		params["is_spot"] = *isSpot
+	if input == nil {
+		input = &CheckInstanceAvailabilityInput{}
 	}
 
 	var available bool
-	req := c.newRequest(op, params, &available)
+	req := c.newRequest(op, input, &available)
+	req.SetContext(ctx)
+
+	// The API answers with a plain boolean, or with the list of available
+	// instance types per location depending on the query parameters
+	req.Handlers.Unmarshal.RemoveByName("datacrunchsdk.restjson.Unmarshal")
+	req.Handlers.Unmarshal.PushBackNamed(availabilityUnmarshalHandler(input))
+	req.ApplyOptions(opts...)
+
+	err := req.Send()
+	if err != nil {
+		return false, err
+	}
 
-	return available, req.Send()
+	return available, nil
 }
-*/
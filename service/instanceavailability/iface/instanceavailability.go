@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"context"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/instanceavailability"
 )
 
@@ -8,7 +11,9 @@ import (
 // instance-availability service client's API operation.
 type InstanceAvailabilityAPI interface {
 	ListInstanceAvailability() ([]*instanceavailability.InstanceAvailabilityResponse, error)
-	// CheckInstanceAvailability(instanceType string, locationCode *string, isSpot *bool) (bool, error)
+	ListInstanceAvailabilityWithContext(ctx context.Context, opts ...request.Option) ([]*instanceavailability.InstanceAvailabilityResponse, error)
+	CheckInstanceAvailability(input *instanceavailability.CheckInstanceAvailabilityInput) (bool, error)
+	CheckInstanceAvailabilityWithContext(ctx context.Context, input *instanceavailability.CheckInstanceAvailabilityInput, opts ...request.Option) (bool, error)
 }
 
 var _ InstanceAvailabilityAPI = (*instanceavailability.InstanceAvailability)(nil)
@@ -53,3 +53,38 @@ func TestListInstanceAvailability_Integration(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckInstanceAvailability_Integration(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	svc := setupIntegrationTest(t)
+
+	instanceAvailability, err := svc.ListInstanceAvailability()
+	if err != nil {
+		t.Fatalf("failed to list instance availability: %v", err)
+	}
+
+	// Pick an instance type the list reports as available
+	var locationCode, instanceType string
+	for _, ia := range instanceAvailability {
+		if len(ia.Availabilities) > 0 {
+			locationCode, instanceType = ia.LocationCode, ia.Availabilities[0]
+			break
+		}
+	}
+	if instanceType == "" {
+		t.Skip("no available instance types to check")
+	}
+
+	available, err := svc.CheckInstanceAvailability(&instanceavailability.CheckInstanceAvailabilityInput{
+		InstanceType: instanceType,
+		LocationCode: &locationCode,
+	})
+	if err != nil {
+		t.Fatalf("failed to check instance availability: %v", err)
+	}
+
+	t.Logf("Instance type %s in %s available: %v", instanceType, locationCode, available)
+}
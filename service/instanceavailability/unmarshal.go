@@ -0,0 +1,112 @@
+package instanceavailability
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/logger"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+// availabilityUnmarshalHandler returns a named request handler decoding a
+// CheckInstanceAvailability response into a *bool.
+func availabilityUnmarshalHandler(input *CheckInstanceAvailabilityInput) request.NamedHandler {
+	return request.NamedHandler{
+		Name: "instanceavailability.AvailabilityUnmarshal",
+		Fn: func(r *request.Request) {
+			unmarshalAvailability(r, input)
+		},
+	}
+}
+
+// unmarshalAvailability reads the response body and decodes it with
+// decodeAvailability.
+func unmarshalAvailability(r *request.Request, input *CheckInstanceAvailabilityInput) {
+	if !r.DataFilled() || r.HTTPResponse.Body == nil {
+		return
+	}
+	defer func() {
+		if err := r.HTTPResponse.Body.Close(); err != nil {
+			logger.Debug("instanceavailability: error closing response body: %v", err)
+		}
+	}()
+
+	available, ok := r.Data.(*bool)
+	if !ok {
+		r.Error = dcerr.New(request.ErrCodeSerialization,
+			fmt.Sprintf("expected *bool data type, got %T", r.Data), nil)
+		return
+	}
+
+	body, err := io.ReadAll(r.HTTPResponse.Body)
+	if err != nil {
+		r.Error = dcerr.New(request.ErrCodeSerialization, "failed to read availability response", err)
+		return
+	}
+
+	v, err := decodeAvailability(body, input)
+	if err != nil {
+		r.Error = dcerr.NewUnmarshalError(err, "failed to decode availability response", body)
+		return
+	}
+	*available = v
+}
+
+// decodeAvailability accepts every shape the availability endpoint answers
+// with: a JSON boolean, a "true"/"false" string (quoted or bare), or a list
+// of available instance types, either per location or flat. For lists, the
+// instance type is available if it is listed, in the requested location if
+// one was given.
+func decodeAvailability(body []byte, input *CheckInstanceAvailabilityInput) (bool, error) {
+	body = bytes.TrimSpace(body)
+
+	var b bool
+	if err := json.Unmarshal(body, &b); err == nil {
+		return b, nil
+	}
+
+	var s string
+	if err := json.Unmarshal(body, &s); err == nil {
+		return strconv.ParseBool(strings.TrimSpace(s))
+	}
+	if b, err := strconv.ParseBool(string(body)); err == nil {
+		return b, nil
+	}
+
+	var locations []*InstanceAvailabilityResponse
+	if err := json.Unmarshal(body, &locations); err == nil {
+		for _, loc := range locations {
+			if loc == nil {
+				continue
+			}
+			if input.LocationCode != nil && loc.LocationCode != *input.LocationCode {
+				continue
+			}
+			if containsString(loc.Availabilities, input.InstanceType) {
+				return true, nil
+			}
+		}
+		return false, nil
+	}
+
+	var instanceTypes []string
+	if err := json.Unmarshal(body, &instanceTypes); err == nil {
+		return containsString(instanceTypes, input.InstanceType), nil
+	}
+
+	return false, fmt.Errorf("unexpected availability response %q", body)
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,58 @@
+package instanceavailability
+
+import (
+	"testing"
+)
+
+func TestDecodeAvailability(t *testing.T) {
+	fin := "FIN-01"
+	ice := "ICE-01"
+	perLocation := `[
+		{"location_code": "FIN-01", "availabilities": ["1V100.6V", "8A100.176V"]},
+		{"location_code": "ICE-01", "availabilities": ["1A100.22V"]}
+	]`
+
+	tests := []struct {
+		name      string
+		body      string
+		location  *string
+		expected  bool
+		expectErr bool
+	}{
+		{name: "boolean true", body: `true`, expected: true},
+		{name: "boolean false", body: ` false `, expected: false},
+		{name: "quoted boolean", body: `"true"`, expected: true},
+		{name: "quoted boolean with spaces", body: `" false "`, expected: false},
+		{name: "bare boolean", body: `True`, expected: true},
+		{name: "per location listed", body: perLocation, expected: true},
+		{name: "per location listed in location", body: perLocation, location: &fin, expected: true},
+		{name: "per location listed in another location", body: perLocation, location: &ice, expected: false},
+		{name: "per location with null entry", body: `[null, {"location_code": "FIN-01", "availabilities": ["1V100.6V"]}]`, expected: true},
+		{name: "empty list", body: `[]`, expected: false},
+		{name: "flat list listed", body: `["1A100.22V", "1V100.6V"]`, expected: true},
+		{name: "flat list not listed", body: `["1A100.22V"]`, expected: false},
+		{name: "quoted non boolean", body: `"maybe"`, expectErr: true},
+		{name: "object", body: `{"available": true}`, expectErr: true},
+		{name: "malformed", body: `[{"location_code": `, expectErr: true},
+		{name: "empty body", body: ``, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := &CheckInstanceAvailabilityInput{InstanceType: "1V100.6V", LocationCode: tt.location}
+			available, err := decodeAvailability([]byte(tt.body), input)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %v", available)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if available != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, available)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package instancetypes
 
 import (
+	"context"
+
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 )
 
@@ -68,6 +70,12 @@ type PriceHistoryResponse struct {
 
 // ListInstanceTypes lists all available instance types
 func (c *InstanceTypes) ListInstanceTypes() ([]*InstanceTypeResponse, error) {
+	return c.ListInstanceTypesWithContext(context.Background())
+}
+
+// ListInstanceTypesWithContext is the same as ListInstanceTypes with the
+// addition of the ability to pass a context and additional request options.
+func (c *InstanceTypes) ListInstanceTypesWithContext(ctx context.Context, opts ...request.Option) ([]*InstanceTypeResponse, error) {
 	op := &request.Operation{
 		Name:       "ListInstanceTypes",
 		HTTPMethod: "GET",
@@ -76,12 +84,21 @@ func (c *InstanceTypes) ListInstanceTypes() ([]*InstanceTypeResponse, error) {
 
 	var instanceTypes []*InstanceTypeResponse
 	req := c.newRequest(op, nil, &instanceTypes)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return instanceTypes, req.Send()
 }
 
 // GetInstanceTypePriceHistory gets the price history for instance types
 func (c *InstanceTypes) GetInstanceTypePriceHistory() (*PriceHistoryResponse, error) {
+	return c.GetInstanceTypePriceHistoryWithContext(context.Background())
+}
+
+// GetInstanceTypePriceHistoryWithContext is the same as
+// GetInstanceTypePriceHistory with the addition of the ability to pass a
+// context and additional request options.
+func (c *InstanceTypes) GetInstanceTypePriceHistoryWithContext(ctx context.Context, opts ...request.Option) (*PriceHistoryResponse, error) {
 	op := &request.Operation{
 		Name:       "GetInstanceTypePriceHistory",
 		HTTPMethod: "GET",
@@ -90,6 +107,8 @@ func (c *InstanceTypes) GetInstanceTypePriceHistory() (*PriceHistoryResponse, er
 
 	var priceHistory PriceHistoryResponse
 	req := c.newRequest(op, nil, &priceHistory)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return &priceHistory, req.Send()
 }
@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"context"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/instancetypes"
 )
 
@@ -8,8 +11,10 @@ import (
 type InstanceTypesAPI interface {
 	// ListInstanceTypes lists all available instance types
 	ListInstanceTypes() ([]*instancetypes.InstanceTypeResponse, error)
+	ListInstanceTypesWithContext(ctx context.Context, opts ...request.Option) ([]*instancetypes.InstanceTypeResponse, error)
 	// GetInstanceTypePriceHistory gets the price history for instance types
 	GetInstanceTypePriceHistory() (*instancetypes.PriceHistoryResponse, error)
+	GetInstanceTypePriceHistoryWithContext(ctx context.Context, opts ...request.Option) (*instancetypes.PriceHistoryResponse, error)
 }
 
 var _ InstanceTypesAPI = (*instancetypes.InstanceTypes)(nil)
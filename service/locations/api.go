@@ -1,6 +1,8 @@
 package locations
 
 import (
+	"context"
+
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 )
 
@@ -13,6 +15,12 @@ type LocationResponse struct {
 
 // ListLocations lists all available locations
 func (c *Locations) ListLocations() ([]*LocationResponse, error) {
+	return c.ListLocationsWithContext(context.Background())
+}
+
+// ListLocationsWithContext is the same as ListLocations with the addition of
+// the ability to pass a context and additional request options.
+func (c *Locations) ListLocationsWithContext(ctx context.Context, opts ...request.Option) ([]*LocationResponse, error) {
 	op := &request.Operation{
 		Name:       "ListLocations",
 		HTTPMethod: "GET",
@@ -21,6 +29,8 @@ func (c *Locations) ListLocations() ([]*LocationResponse, error) {
 
 	var locations []*LocationResponse
 	req := c.newRequest(op, nil, &locations)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return locations, req.Send()
 }
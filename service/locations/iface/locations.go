@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"context"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/locations"
 )
 
@@ -8,6 +11,7 @@ import (
 type LocationsAPI interface {
 	// ListLocations lists all available locations
 	ListLocations() ([]*locations.LocationResponse, error)
+	ListLocationsWithContext(ctx context.Context, opts ...request.Option) ([]*locations.LocationResponse, error)
 }
 
 var _ LocationsAPI = (*locations.Locations)(nil)
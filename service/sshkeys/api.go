@@ -1,6 +1,8 @@
 package sshkeys
 
 import (
+	"context"
+
 	"github.com/datacrunch-io/datacrunch-sdk-go/internal/protocol/restjson"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 )
@@ -24,6 +26,12 @@ type SSHKeyResponse struct {
 
 // ListSSHKeys lists all SSH keys
 func (c *SSHKey) ListSSHKeys() ([]*SSHKeyResponse, error) {
+	return c.ListSSHKeysWithContext(context.Background())
+}
+
+// ListSSHKeysWithContext is the same as ListSSHKeys with the addition of the
+// ability to pass a context and additional request options.
+func (c *SSHKey) ListSSHKeysWithContext(ctx context.Context, opts ...request.Option) ([]*SSHKeyResponse, error) {
 	op := &request.Operation{
 		Name:       "ListSSHKeys",
 		HTTPMethod: "GET",
@@ -32,6 +40,8 @@ func (c *SSHKey) ListSSHKeys() ([]*SSHKeyResponse, error) {
 
 	var sshKeys []*SSHKeyResponse
 	req := c.newRequest(op, nil, &sshKeys)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return sshKeys, req.Send()
 }
@@ -42,6 +52,12 @@ type GetSSHKeyInput struct {
 
 // GetSSHKey gets a single SSH key by ID
 func (c *SSHKey) GetSSHKey(id string) ([]*SSHKeyResponse, error) {
+	return c.GetSSHKeyWithContext(context.Background(), id)
+}
+
+// GetSSHKeyWithContext is the same as GetSSHKey with the addition of the
+// ability to pass a context and additional request options.
+func (c *SSHKey) GetSSHKeyWithContext(ctx context.Context, id string, opts ...request.Option) ([]*SSHKeyResponse, error) {
 	op := &request.Operation{
 		Name:       "GetSSHKey",
 		HTTPMethod: "GET",
@@ -50,12 +66,20 @@ func (c *SSHKey) GetSSHKey(id string) ([]*SSHKeyResponse, error) {
 
 	var sshKey []*SSHKeyResponse
 	req := c.newRequest(op, &GetSSHKeyInput{ID: id}, &sshKey)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return sshKey, req.Send()
 }
 
 // CreateSSHKey creates a new SSH key
 func (c *SSHKey) CreateSSHKey(input *CreateSSHKeyInput) (string, error) {
+	return c.CreateSSHKeyWithContext(context.Background(), input)
+}
+
+// CreateSSHKeyWithContext is the same as CreateSSHKey with the addition of the
+// ability to pass a context and additional request options.
+func (c *SSHKey) CreateSSHKeyWithContext(ctx context.Context, input *CreateSSHKeyInput, opts ...request.Option) (string, error) {
 	op := &request.Operation{
 		Name:       "CreateSSHKey",
 		HTTPMethod: "POST",
@@ -64,15 +88,23 @@ func (c *SSHKey) CreateSSHKey(input *CreateSSHKeyInput) (string, error) {
 
 	var sshKey string
 	req := c.newRequest(op, input, &sshKey)
+	req.SetContext(ctx)
 
 	req.Handlers.Unmarshal.RemoveByName("datacrunchsdk.restjson.Unmarshal")
 	req.Handlers.Unmarshal.PushBackNamed(restjson.StringUnmarshalHandler)
+	req.ApplyOptions(opts...)
 
 	return sshKey, req.Send()
 }
 
 // DeleteSSHKeys deletes multiple SSH keys
 func (c *SSHKey) DeleteSSHKeys(input *DeleteSSHKeysInput) error {
+	return c.DeleteSSHKeysWithContext(context.Background(), input)
+}
+
+// DeleteSSHKeysWithContext is the same as DeleteSSHKeys with the addition of
+// the ability to pass a context and additional request options.
+func (c *SSHKey) DeleteSSHKeysWithContext(ctx context.Context, input *DeleteSSHKeysInput, opts ...request.Option) error {
 	op := &request.Operation{
 		Name:       "DeleteSSHKeys",
 		HTTPMethod: "DELETE",
@@ -80,6 +112,8 @@ func (c *SSHKey) DeleteSSHKeys(input *DeleteSSHKeysInput) error {
 	}
 
 	req := c.newRequest(op, input, nil)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	if err := req.Send(); err != nil {
 		return err
@@ -94,6 +128,12 @@ type DeleteSSHKeyInput struct {
 
 // DeleteSSHKey deletes a single SSH key by ID
 func (c *SSHKey) DeleteSSHKey(id string) error {
+	return c.DeleteSSHKeyWithContext(context.Background(), id)
+}
+
+// DeleteSSHKeyWithContext is the same as DeleteSSHKey with the addition of the
+// ability to pass a context and additional request options.
+func (c *SSHKey) DeleteSSHKeyWithContext(ctx context.Context, id string, opts ...request.Option) error {
 	op := &request.Operation{
 		Name:       "DeleteSSHKey",
 		HTTPMethod: "DELETE",
@@ -105,6 +145,8 @@ func (c *SSHKey) DeleteSSHKey(id string) error {
 	}
 
 	req := c.newRequest(op, input, nil)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return req.Send()
 }
@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"context"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/sshkeys"
 )
 
@@ -8,14 +11,19 @@ import (
 type SSHKeyAPI interface {
 	// ListSSHKeys lists all SSH keys
 	ListSSHKeys() ([]*sshkeys.SSHKeyResponse, error)
+	ListSSHKeysWithContext(ctx context.Context, opts ...request.Option) ([]*sshkeys.SSHKeyResponse, error)
 	// GetSSHKey gets a single SSH key by ID
 	GetSSHKey(id string) ([]*sshkeys.SSHKeyResponse, error)
+	GetSSHKeyWithContext(ctx context.Context, id string, opts ...request.Option) ([]*sshkeys.SSHKeyResponse, error)
 	// CreateSSHKey creates a new SSH key
 	CreateSSHKey(input *sshkeys.CreateSSHKeyInput) (string, error)
+	CreateSSHKeyWithContext(ctx context.Context, input *sshkeys.CreateSSHKeyInput, opts ...request.Option) (string, error)
 	// DeleteSSHKeys deletes multiple SSH keys
 	DeleteSSHKeys(input *sshkeys.DeleteSSHKeysInput) error
+	DeleteSSHKeysWithContext(ctx context.Context, input *sshkeys.DeleteSSHKeysInput, opts ...request.Option) error
 	// DeleteSSHKey deletes a single SSH key by ID
 	DeleteSSHKey(id string) error
+	DeleteSSHKeyWithContext(ctx context.Context, id string, opts ...request.Option) error
 }
 
 var _ SSHKeyAPI = (*sshkeys.SSHKey)(nil)
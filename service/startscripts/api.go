@@ -1,6 +1,7 @@
 package startscripts
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/datacrunch-io/datacrunch-sdk-go/internal/protocol/restjson"
@@ -35,6 +36,12 @@ type DeleteStartScriptInput struct {
 
 // ListStartScripts lists all startup scripts
 func (c *StartScripts) ListStartScripts() ([]*StartScriptResponse, error) {
+	return c.ListStartScriptsWithContext(context.Background())
+}
+
+// ListStartScriptsWithContext is the same as ListStartScripts with the
+// addition of the ability to pass a context and additional request options.
+func (c *StartScripts) ListStartScriptsWithContext(ctx context.Context, opts ...request.Option) ([]*StartScriptResponse, error) {
 	op := &request.Operation{
 		Name:       "ListStartScripts",
 		HTTPMethod: "GET",
@@ -43,12 +50,20 @@ func (c *StartScripts) ListStartScripts() ([]*StartScriptResponse, error) {
 
 	var scripts []*StartScriptResponse
 	req := c.newRequest(op, nil, &scripts)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return scripts, req.Send()
 }
 
 // GetStartScript gets a single startup script by ID
 func (c *StartScripts) GetStartScript(id string) ([]*StartScriptResponse, error) {
+	return c.GetStartScriptWithContext(context.Background(), id)
+}
+
+// GetStartScriptWithContext is the same as GetStartScript with the addition
+// of the ability to pass a context and additional request options.
+func (c *StartScripts) GetStartScriptWithContext(ctx context.Context, id string, opts ...request.Option) ([]*StartScriptResponse, error) {
 	op := &request.Operation{
 		Name:       "GetStartScript",
 		HTTPMethod: "GET",
@@ -62,6 +77,8 @@ func (c *StartScripts) GetStartScript(id string) ([]*StartScriptResponse, error)
 	// API returns array, so unmarshal as array and take first element
 	var scripts []*StartScriptResponse
 	req := c.newRequest(op, input, &scripts)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	err := req.Send()
 	if err != nil {
@@ -73,6 +90,12 @@ func (c *StartScripts) GetStartScript(id string) ([]*StartScriptResponse, error)
 
 // CreateStartScript creates a new startup script
 func (c *StartScripts) CreateStartScript(input *CreateStartScriptInput) (string, error) {
+	return c.CreateStartScriptWithContext(context.Background(), input)
+}
+
+// CreateStartScriptWithContext is the same as CreateStartScript with the
+// addition of the ability to pass a context and additional request options.
+func (c *StartScripts) CreateStartScriptWithContext(ctx context.Context, input *CreateStartScriptInput, opts ...request.Option) (string, error) {
 	op := &request.Operation{
 		Name:       "CreateStartScript",
 		HTTPMethod: "POST",
@@ -81,10 +104,12 @@ func (c *StartScripts) CreateStartScript(input *CreateStartScriptInput) (string,
 
 	var scriptID string
 	req := c.newRequest(op, input, &scriptID)
+	req.SetContext(ctx)
 
 	// This API returns a plain string, not JSON, so use string unmarshaler
 	req.Handlers.Unmarshal.RemoveByName("datacrunchsdk.restjson.Unmarshal")
 	req.Handlers.Unmarshal.PushBackNamed(restjson.StringUnmarshalHandler)
+	req.ApplyOptions(opts...)
 
 	err := req.Send()
 	if err != nil {
@@ -96,6 +121,12 @@ func (c *StartScripts) CreateStartScript(input *CreateStartScriptInput) (string,
 
 // DeleteStartScripts deletes multiple startup scripts
 func (c *StartScripts) DeleteStartScripts(input *DeleteStartScriptsInput) error {
+	return c.DeleteStartScriptsWithContext(context.Background(), input)
+}
+
+// DeleteStartScriptsWithContext is the same as DeleteStartScripts with the
+// addition of the ability to pass a context and additional request options.
+func (c *StartScripts) DeleteStartScriptsWithContext(ctx context.Context, input *DeleteStartScriptsInput, opts ...request.Option) error {
 	op := &request.Operation{
 		Name:       "DeleteStartScripts",
 		HTTPMethod: "DELETE",
@@ -103,12 +134,20 @@ func (c *StartScripts) DeleteStartScripts(input *DeleteStartScriptsInput) error
 	}
 
 	req := c.newRequest(op, input, nil)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return req.Send()
 }
 
 // DeleteStartScript deletes a single startup script by ID
 func (c *StartScripts) DeleteStartScript(id string) error {
+	return c.DeleteStartScriptWithContext(context.Background(), id)
+}
+
+// DeleteStartScriptWithContext is the same as DeleteStartScript with the
+// addition of the ability to pass a context and additional request options.
+func (c *StartScripts) DeleteStartScriptWithContext(ctx context.Context, id string, opts ...request.Option) error {
 	op := &request.Operation{
 		Name:       "DeleteStartScript",
 		HTTPMethod: "DELETE",
@@ -120,6 +159,8 @@ func (c *StartScripts) DeleteStartScript(id string) error {
 	}
 
 	req := c.newRequest(op, input, nil)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return req.Send()
 }
@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"context"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/startscripts"
 )
 
@@ -8,14 +11,19 @@ import (
 type StartScriptsAPI interface {
 	// ListStartScripts lists all startup scripts
 	ListStartScripts() ([]*startscripts.StartScriptResponse, error)
+	ListStartScriptsWithContext(ctx context.Context, opts ...request.Option) ([]*startscripts.StartScriptResponse, error)
 	// GetStartScript gets a single startup script by ID
 	GetStartScript(id string) ([]*startscripts.StartScriptResponse, error)
+	GetStartScriptWithContext(ctx context.Context, id string, opts ...request.Option) ([]*startscripts.StartScriptResponse, error)
 	// CreateStartScript creates a new startup script
 	CreateStartScript(input *startscripts.CreateStartScriptInput) (string, error)
+	CreateStartScriptWithContext(ctx context.Context, input *startscripts.CreateStartScriptInput, opts ...request.Option) (string, error)
 	// DeleteStartScripts deletes multiple startup scripts
 	DeleteStartScripts(input *startscripts.DeleteStartScriptsInput) error
+	DeleteStartScriptsWithContext(ctx context.Context, input *startscripts.DeleteStartScriptsInput, opts ...request.Option) error
 	// DeleteStartScript deletes a single startup script by ID
 	DeleteStartScript(id string) error
+	DeleteStartScriptWithContext(ctx context.Context, id string, opts ...request.Option) error
 }
 
 var _ StartScriptsAPI = (*startscripts.StartScripts)(nil)
@@ -0,0 +1,245 @@
+package volumes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/protocol/restjson"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+const (
+	// ErrCodeVolumeShrink is returned by ResizeVolume when the new size is not
+	// larger than the current one. Volumes can only grow.
+	ErrCodeVolumeShrink = "VolumeShrinkNotSupported"
+
+	// ErrCodeVolumeNotInTrash is returned by RestoreVolume when the volume is
+	// not in the trash.
+	ErrCodeVolumeNotInTrash = "VolumeNotInTrash"
+)
+
+// AttachVolume attaches a detached volume to an instance. The instance must
+// be shut down.
+func (c *Volumes) AttachVolume(id, instanceID string) error {
+	return c.AttachVolumeWithContext(context.Background(), id, instanceID)
+}
+
+// AttachVolumeWithContext is the same as AttachVolume with the addition of
+// the ability to pass a context and additional request options.
+func (c *Volumes) AttachVolumeWithContext(ctx context.Context, id, instanceID string, opts ...request.Option) error {
+	if err := requireParam(id, "volume ID"); err != nil {
+		return err
+	}
+	if err := requireParam(instanceID, "instance ID"); err != nil {
+		return err
+	}
+	return c.PerformVolumeActionWithContext(ctx, &VolumeActionInput{
+		Action:     VolumeActionAttach,
+		ID:         id,
+		InstanceID: instanceID,
+	}, opts...)
+}
+
+// DetachVolume detaches a volume from the instances it is attached to. The
+// instances must be shut down.
+func (c *Volumes) DetachVolume(id string) error {
+	return c.DetachVolumeWithContext(context.Background(), id)
+}
+
+// DetachVolumeWithContext is the same as DetachVolume with the addition of
+// the ability to pass a context and additional request options.
+func (c *Volumes) DetachVolumeWithContext(ctx context.Context, id string, opts ...request.Option) error {
+	if err := requireParam(id, "volume ID"); err != nil {
+		return err
+	}
+	return c.PerformVolumeActionWithContext(ctx, &VolumeActionInput{
+		Action: VolumeActionDetach,
+		ID:     id,
+	}, opts...)
+}
+
+// ResizeVolume grows a volume to the given size in GB. An error with the
+// ErrCodeVolumeShrink code is returned, without resizing, if the size is not
+// larger than the current size of the volume.
+func (c *Volumes) ResizeVolume(id string, size int64) error {
+	return c.ResizeVolumeWithContext(context.Background(), id, size)
+}
+
+// ResizeVolumeWithContext is the same as ResizeVolume with the addition of
+// the ability to pass a context and additional request options. The options
+// only apply to the resize action, not to the request reading the current
+// size.
+func (c *Volumes) ResizeVolumeWithContext(ctx context.Context, id string, size int64, opts ...request.Option) error {
+	if err := requireParam(id, "volume ID"); err != nil {
+		return err
+	}
+	if size <= 0 {
+		return dcerr.New(request.ErrCodeInvalidParameter,
+			fmt.Sprintf("volume size must be positive, got %d", size), nil)
+	}
+
+	volume, err := c.GetVolumeWithContext(ctx, id)
+	if err != nil {
+		return err
+	}
+	if size <= volume.Size {
+		return dcerr.New(ErrCodeVolumeShrink,
+			fmt.Sprintf("volume %s is %d GB and can only grow, got %d GB", id, volume.Size, size), nil)
+	}
+
+	return c.PerformVolumeActionWithContext(ctx, &VolumeActionInput{
+		Action: VolumeActionResize,
+		ID:     id,
+		Size:   size,
+	}, opts...)
+}
+
+// RenameVolume changes the name of a volume
+func (c *Volumes) RenameVolume(id, name string) error {
+	return c.RenameVolumeWithContext(context.Background(), id, name)
+}
+
+// RenameVolumeWithContext is the same as RenameVolume with the addition of
+// the ability to pass a context and additional request options.
+func (c *Volumes) RenameVolumeWithContext(ctx context.Context, id, name string, opts ...request.Option) error {
+	if err := requireParam(id, "volume ID"); err != nil {
+		return err
+	}
+	if err := requireParam(name, "volume name"); err != nil {
+		return err
+	}
+	return c.PerformVolumeActionWithContext(ctx, &VolumeActionInput{
+		Action: VolumeActionRename,
+		ID:     id,
+		Name:   name,
+	}, opts...)
+}
+
+// CloneVolumeInput represents input for cloning a volume
+type CloneVolumeInput struct {
+	// ID is the volume to clone
+	ID string
+
+	// Name is the name of the clone, generated by the API if empty
+	Name string
+
+	// Type is the volume type of the clone, the type of the source volume
+	// if empty
+	Type VolumeType
+
+	// LocationCode is the location of the clone, the location of the source
+	// volume if empty
+	LocationCode string
+}
+
+// CloneVolume clones a volume, possibly to another location or volume type,
+// and returns the ID of the clone.
+func (c *Volumes) CloneVolume(input *CloneVolumeInput) (string, error) {
+	return c.CloneVolumeWithContext(context.Background(), input)
+}
+
+// CloneVolumeWithContext is the same as CloneVolume with the addition of the
+// ability to pass a context and additional request options.
+func (c *Volumes) CloneVolumeWithContext(ctx context.Context, input *CloneVolumeInput, opts ...request.Option) (string, error) {
+	if input == nil {
+		input = &CloneVolumeInput{}
+	}
+	if err := requireParam(input.ID, "volume ID"); err != nil {
+		return "", err
+	}
+
+	op := &request.Operation{
+		Name:       "CloneVolume",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/volumes",
+	}
+
+	var body string
+	req := c.newRequest(op, &VolumeActionInput{
+		Action:       VolumeActionClone,
+		ID:           input.ID,
+		Name:         input.Name,
+		Type:         input.Type,
+		LocationCode: input.LocationCode,
+	}, &body)
+	req.SetContext(ctx)
+
+	req.Handlers.Unmarshal.RemoveByName("datacrunchsdk.restjson.Unmarshal")
+	req.Handlers.Unmarshal.PushBackNamed(restjson.StringUnmarshalHandler)
+	req.ApplyOptions(opts...)
+
+	if err := req.Send(); err != nil {
+		return "", err
+	}
+	return parseVolumeID(body), nil
+}
+
+// RestoreVolume restores a volume from the trash. An error with the
+// ErrCodeVolumeNotInTrash code is returned if the volume is not in the trash.
+func (c *Volumes) RestoreVolume(id string) error {
+	return c.RestoreVolumeWithContext(context.Background(), id)
+}
+
+// RestoreVolumeWithContext is the same as RestoreVolume with the addition of
+// the ability to pass a context and additional request options. The options
+// only apply to the restore action, not to the request listing the trash.
+func (c *Volumes) RestoreVolumeWithContext(ctx context.Context, id string, opts ...request.Option) error {
+	if err := requireParam(id, "volume ID"); err != nil {
+		return err
+	}
+
+	trash, err := c.ListTrashVolumesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if findVolume(trash, id) == nil {
+		return dcerr.New(ErrCodeVolumeNotInTrash,
+			fmt.Sprintf("volume %s is not in the trash", id), nil)
+	}
+
+	return c.PerformVolumeActionWithContext(ctx, &VolumeActionInput{
+		Action: VolumeActionRestore,
+		ID:     id,
+	}, opts...)
+}
+
+// requireParam returns an invalid parameter error if value is empty.
+func requireParam(value, name string) error {
+	if value == "" {
+		return dcerr.New(request.ErrCodeInvalidParameter, name+" is required", nil)
+	}
+	return nil
+}
+
+// findVolume returns the volume with the given ID, or nil if not listed.
+func findVolume(volumes []*VolumeResponse, id string) *VolumeResponse {
+	for _, v := range volumes {
+		if v != nil && v.ID == id {
+			return v
+		}
+	}
+	return nil
+}
+
+// parseVolumeID extracts a volume ID from an action response, which is
+// either a plain ID, a JSON string or a JSON list of IDs.
+func parseVolumeID(body string) string {
+	body = strings.TrimSpace(body)
+
+	var ids []string
+	if err := json.Unmarshal([]byte(body), &ids); err == nil {
+		if len(ids) == 0 {
+			return ""
+		}
+		return ids[0]
+	}
+
+	var id string
+	if err := json.Unmarshal([]byte(body), &id); err == nil {
+		return id
+	}
+	return body
+}
@@ -0,0 +1,60 @@
+package volumes
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+func TestVolumeActions_OptionsOnlyApplyToAction(t *testing.T) {
+	tests := []struct {
+		name     string
+		action   func(c *Volumes, opts ...request.Option) error
+		expected []string
+	}{
+		{
+			name: "resize",
+			action: func(c *Volumes, opts ...request.Option) error {
+				return c.ResizeVolumeWithContext(context.Background(), "vol-1", 100, opts...)
+			},
+			expected: []string{"GET /volumes/vol-1 ", "PUT /volumes key-1"},
+		},
+		{
+			name: "restore",
+			action: func(c *Volumes, opts ...request.Option) error {
+				return c.RestoreVolumeWithContext(context.Background(), "vol-1", opts...)
+			},
+			expected: []string{"GET /volumes/trash ", "PUT /volumes key-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var requests []string
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get(request.IdempotencyKeyHeader))
+				mu.Unlock()
+
+				switch r.URL.Path {
+				case "/volumes/vol-1":
+					_, _ = w.Write([]byte(`{"id":"vol-1","size":50}`))
+				case "/volumes/trash":
+					_, _ = w.Write([]byte(`[{"id":"vol-1"}]`))
+				}
+			})
+
+			if err := tt.action(c, request.WithIdempotencyKey("key-1")); err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if !reflect.DeepEqual(requests, tt.expected) {
+				t.Errorf("expected requests %q, got %q", tt.expected, requests)
+			}
+		})
+	}
+}
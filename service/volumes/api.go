@@ -1,6 +1,8 @@
 package volumes
 
 import (
+	"context"
+
 	"github.com/datacrunch-io/datacrunch-sdk-go/internal/protocol/restjson"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 )
@@ -28,50 +30,63 @@ type LongTerm struct {
 
 // VolumeResponse represents a volume
 type VolumeResponse struct {
-	ID                       string     `json:"id"`
-	InstanceID               string     `json:"instance_id"`
-	Instances                []Instance `json:"instances"`
-	Name                     string     `json:"name"`
-	CreatedAt                string     `json:"created_at"`
-	Status                   string     `json:"status"`
-	Size                     int64      `json:"size"`
-	IsOSVolume               bool       `json:"is_os_volume"`
-	Target                   string     `json:"target"`
-	Type                     string     `json:"type"`
-	Location                 string     `json:"location"`
-	SSHKeyIDs                []string   `json:"ssh_key_ids"`
-	PseudoPath               string     `json:"pseudo_path"`
-	CreateDirectoryCommand   string     `json:"create_directory_command"`
-	MountCommand             string     `json:"mount_command"`
-	FilesystemToFstabCommand string     `json:"filesystem_to_fstab_command"`
-	Contract                 string     `json:"contract"`
-	BaseHourlyCost           float64    `json:"base_hourly_cost"`
-	MonthlyPrice             float64    `json:"monthly_price"`
-	Currency                 string     `json:"currency"`
-	LongTerm                 *LongTerm  `json:"long_term"`
-	DeletedAt                string     `json:"deleted_at,omitempty"`
+	ID                       string       `json:"id"`
+	InstanceID               string       `json:"instance_id"`
+	Instances                []Instance   `json:"instances"`
+	Name                     string       `json:"name"`
+	CreatedAt                string       `json:"created_at"`
+	Status                   VolumeStatus `json:"status"`
+	Size                     int64        `json:"size"`
+	IsOSVolume               bool         `json:"is_os_volume"`
+	Target                   string       `json:"target"`
+	Type                     VolumeType   `json:"type"`
+	Location                 string       `json:"location"`
+	SSHKeyIDs                []string     `json:"ssh_key_ids"`
+	PseudoPath               string       `json:"pseudo_path"`
+	CreateDirectoryCommand   string       `json:"create_directory_command"`
+	MountCommand             string       `json:"mount_command"`
+	FilesystemToFstabCommand string       `json:"filesystem_to_fstab_command"`
+	Contract                 ContractType `json:"contract"`
+	BaseHourlyCost           float64      `json:"base_hourly_cost"`
+	MonthlyPrice             float64      `json:"monthly_price"`
+	Currency                 string       `json:"currency"`
+	LongTerm                 *LongTerm    `json:"long_term"`
+	DeletedAt                string       `json:"deleted_at,omitempty"`
 }
 
 // CreateVolumeInput represents input for creating a volume
 type CreateVolumeInput struct {
-	Type         string   `json:"type"`
-	LocationCode string   `json:"location_code"`
-	Size         int64    `json:"size"`
-	InstanceIDs  []string `json:"instance_ids,omitempty"`
-	Name         string   `json:"name"`
+	Type         VolumeType `json:"type"`
+	LocationCode string     `json:"location_code"`
+	Size         int64      `json:"size"`
+	InstanceIDs  []string   `json:"instance_ids,omitempty"`
+	Name         string     `json:"name"`
 }
 
+// VolumeActionType represents the type of action to perform on a volume
+type VolumeActionType string
+
+const (
+	VolumeActionAttach  VolumeActionType = "attach"
+	VolumeActionDetach  VolumeActionType = "detach"
+	VolumeActionResize  VolumeActionType = "resize"
+	VolumeActionRename  VolumeActionType = "rename"
+	VolumeActionClone   VolumeActionType = "clone"
+	VolumeActionRestore VolumeActionType = "restore"
+	VolumeActionDelete  VolumeActionType = "delete"
+)
+
 // VolumeActionInput represents input for performing an action on a volume
 type VolumeActionInput struct {
-	Action       string   `json:"action"`
-	ID           string   `json:"id"`
-	Size         int64    `json:"size,omitempty"`
-	InstanceID   string   `json:"instance_id,omitempty"`
-	InstanceIDs  []string `json:"instance_ids,omitempty"`
-	Name         string   `json:"name,omitempty"`
-	Type         string   `json:"type,omitempty"`
-	IsPermanent  bool     `json:"is_permanent,omitempty"`
-	LocationCode string   `json:"location_code,omitempty"`
+	Action       VolumeActionType `json:"action"`
+	ID           string           `json:"id"`
+	Size         int64            `json:"size,omitempty"`
+	InstanceID   string           `json:"instance_id,omitempty"`
+	InstanceIDs  []string         `json:"instance_ids,omitempty"`
+	Name         string           `json:"name,omitempty"`
+	Type         VolumeType       `json:"type,omitempty"`
+	IsPermanent  bool             `json:"is_permanent,omitempty"`
+	LocationCode string           `json:"location_code,omitempty"`
 }
 
 // VolumeStatus represents the possible status values for a volume.
@@ -79,9 +94,12 @@ type VolumeStatus string
 
 const (
 	VolumeStatusOrdered   VolumeStatus = "ordered"
+	VolumeStatusCreating  VolumeStatus = "creating"
 	VolumeStatusAttached  VolumeStatus = "attached"
 	VolumeStatusAttaching VolumeStatus = "attaching"
 	VolumeStatusDetached  VolumeStatus = "detached"
+	VolumeStatusCloning   VolumeStatus = "cloning"
+	VolumeStatusDeleting  VolumeStatus = "deleting"
 	VolumeStatusDeleted   VolumeStatus = "deleted"
 )
 
@@ -91,6 +109,12 @@ type ListVolumesStatus struct {
 
 // ListVolumes lists all volumes
 func (c *Volumes) ListVolumes(status *ListVolumesStatus) ([]*VolumeResponse, error) {
+	return c.ListVolumesWithContext(context.Background(), status)
+}
+
+// ListVolumesWithContext is the same as ListVolumes with the addition of the
+// ability to pass a context and additional request options.
+func (c *Volumes) ListVolumesWithContext(ctx context.Context, status *ListVolumesStatus, opts ...request.Option) ([]*VolumeResponse, error) {
 	op := &request.Operation{
 		Name:       "ListVolumes",
 		HTTPMethod: "GET",
@@ -99,6 +123,8 @@ func (c *Volumes) ListVolumes(status *ListVolumesStatus) ([]*VolumeResponse, err
 
 	var volumes []*VolumeResponse
 	req := c.newRequest(op, status, &volumes)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return volumes, req.Send()
 }
@@ -109,20 +135,39 @@ type GetVolumeInput struct {
 
 // GetVolume gets a volume by ID
 func (c *Volumes) GetVolume(id string) (*VolumeResponse, error) {
+	return c.GetVolumeWithContext(context.Background(), id)
+}
+
+// GetVolumeWithContext is the same as GetVolume with the addition of the
+// ability to pass a context and additional request options.
+func (c *Volumes) GetVolumeWithContext(ctx context.Context, id string, opts ...request.Option) (*VolumeResponse, error) {
+	req, volume := c.getVolumeRequest(id)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
+
+	return volume, req.Send()
+}
+
+// getVolumeRequest generates a request for the GetVolume operation.
+func (c *Volumes) getVolumeRequest(id string) (*request.Request, *VolumeResponse) {
 	op := &request.Operation{
 		Name:       "GetVolume",
 		HTTPMethod: "GET",
 		HTTPPath:   "/volumes/{id}",
 	}
 
-	var volume VolumeResponse
-	req := c.newRequest(op, &GetVolumeInput{ID: id}, &volume)
-
-	return &volume, req.Send()
+	volume := &VolumeResponse{}
+	return c.newRequest(op, &GetVolumeInput{ID: id}, volume), volume
 }
 
 // CreateVolume creates a new volume
 func (c *Volumes) CreateVolume(input *CreateVolumeInput) (string, error) {
+	return c.CreateVolumeWithContext(context.Background(), input)
+}
+
+// CreateVolumeWithContext is the same as CreateVolume with the addition of the
+// ability to pass a context and additional request options.
+func (c *Volumes) CreateVolumeWithContext(ctx context.Context, input *CreateVolumeInput, opts ...request.Option) (string, error) {
 	op := &request.Operation{
 		Name:       "CreateVolume",
 		HTTPMethod: "POST",
@@ -131,15 +176,23 @@ func (c *Volumes) CreateVolume(input *CreateVolumeInput) (string, error) {
 
 	var volumeID string
 	req := c.newRequest(op, input, &volumeID)
+	req.SetContext(ctx)
 
 	req.Handlers.Unmarshal.RemoveByName("datacrunchsdk.restjson.Unmarshal")
 	req.Handlers.Unmarshal.PushBackNamed(restjson.StringUnmarshalHandler)
+	req.ApplyOptions(opts...)
 
 	return volumeID, req.Send()
 }
 
 // PerformVolumeAction performs an action on a volume
 func (c *Volumes) PerformVolumeAction(input *VolumeActionInput) error {
+	return c.PerformVolumeActionWithContext(context.Background(), input)
+}
+
+// PerformVolumeActionWithContext is the same as PerformVolumeAction with the
+// addition of the ability to pass a context and additional request options.
+func (c *Volumes) PerformVolumeActionWithContext(ctx context.Context, input *VolumeActionInput, opts ...request.Option) error {
 	op := &request.Operation{
 		Name:       "PerformVolumeAction",
 		HTTPMethod: "PUT",
@@ -147,12 +200,20 @@ func (c *Volumes) PerformVolumeAction(input *VolumeActionInput) error {
 	}
 
 	req := c.newRequest(op, input, nil)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return req.Send()
 }
 
 // ListTrashVolumes lists all volumes in trash
 func (c *Volumes) ListTrashVolumes() ([]*VolumeResponse, error) {
+	return c.ListTrashVolumesWithContext(context.Background())
+}
+
+// ListTrashVolumesWithContext is the same as ListTrashVolumes with the
+// addition of the ability to pass a context and additional request options.
+func (c *Volumes) ListTrashVolumesWithContext(ctx context.Context, opts ...request.Option) ([]*VolumeResponse, error) {
 	op := &request.Operation{
 		Name:       "ListTrashVolumes",
 		HTTPMethod: "GET",
@@ -161,23 +222,35 @@ func (c *Volumes) ListTrashVolumes() ([]*VolumeResponse, error) {
 
 	var volumes []*VolumeResponse
 	req := c.newRequest(op, nil, &volumes)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return volumes, req.Send()
 }
 
 type DeleteVolumeInput struct {
-	ID string `location:"uri" locationName:"id"`
+	ID          string `location:"uri" locationName:"id"`
+	IsPermanent bool   `json:"is_permanent"`
 }
 
-// DeleteVolume deletes a volume by ID
+// DeleteVolume deletes a volume by ID. The volume is moved to the trash,
+// unless isPermanent is true in which case it is deleted for good.
 func (c *Volumes) DeleteVolume(id string, isPermanent bool) error {
+	return c.DeleteVolumeWithContext(context.Background(), id, isPermanent)
+}
+
+// DeleteVolumeWithContext is the same as DeleteVolume with the addition of the
+// ability to pass a context and additional request options.
+func (c *Volumes) DeleteVolumeWithContext(ctx context.Context, id string, isPermanent bool, opts ...request.Option) error {
 	op := &request.Operation{
 		Name:       "DeleteVolume",
 		HTTPMethod: "DELETE",
 		HTTPPath:   "/volumes/{id}",
 	}
 
-	req := c.newRequest(op, &DeleteVolumeInput{ID: id}, nil)
+	req := c.newRequest(op, &DeleteVolumeInput{ID: id, IsPermanent: isPermanent}, nil)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return req.Send()
 }
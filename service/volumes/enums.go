@@ -0,0 +1,60 @@
+package volumes
+
+import (
+	"github.com/datacrunch-io/datacrunch-sdk-go/internal/util"
+)
+
+// VolumeType is the storage type of a volume
+type VolumeType string
+
+const (
+	VolumeTypeNVMe       VolumeType = "NVMe"
+	VolumeTypeHDD        VolumeType = "HDD"
+	VolumeTypeNVMeShared VolumeType = "NVMe_Shared"
+	VolumeTypeHDDShared  VolumeType = "HDD_Shared"
+)
+
+// UnmarshalJSON decodes the volume type, keeping values unknown to the SDK as
+// is.
+func (t *VolumeType) UnmarshalJSON(data []byte) error {
+	*t = VolumeType(util.DecodeEnum(data))
+	return nil
+}
+
+// ContractType is the contract a volume is billed under
+type ContractType string
+
+const (
+	ContractPayAsYouGo ContractType = "PAY_AS_YOU_GO"
+	ContractLongTerm   ContractType = "LONG_TERM"
+)
+
+// UnmarshalJSON decodes the contract type, keeping values unknown to the SDK
+// as is.
+func (t *ContractType) UnmarshalJSON(data []byte) error {
+	*t = ContractType(util.DecodeEnum(data))
+	return nil
+}
+
+// UnmarshalJSON decodes the volume status, keeping values unknown to the SDK
+// as is.
+func (s *VolumeStatus) UnmarshalJSON(data []byte) error {
+	*s = VolumeStatus(util.DecodeEnum(data))
+	return nil
+}
+
+// IsTerminal returns true if the volume will not change status anymore.
+func (s VolumeStatus) IsTerminal() bool {
+	return s == VolumeStatusDeleted
+}
+
+// IsTransitional returns true if the volume is on its way to another status,
+// such as being created or attached.
+func (s VolumeStatus) IsTransitional() bool {
+	switch s {
+	case VolumeStatusOrdered, VolumeStatusCreating, VolumeStatusAttaching,
+		VolumeStatusCloning, VolumeStatusDeleting:
+		return true
+	}
+	return false
+}
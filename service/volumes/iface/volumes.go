@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"context"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumes"
 )
 
@@ -8,16 +11,58 @@ import (
 type VolumesAPI interface {
 	// ListVolumes lists all volumes
 	ListVolumes(status *volumes.ListVolumesStatus) ([]*volumes.VolumeResponse, error)
+	ListVolumesWithContext(ctx context.Context, status *volumes.ListVolumesStatus, opts ...request.Option) ([]*volumes.VolumeResponse, error)
 	// GetVolume gets a volume by ID
 	GetVolume(id string) (*volumes.VolumeResponse, error)
+	GetVolumeWithContext(ctx context.Context, id string, opts ...request.Option) (*volumes.VolumeResponse, error)
 	// CreateVolume creates a new volume
 	CreateVolume(input *volumes.CreateVolumeInput) (string, error)
+	CreateVolumeWithContext(ctx context.Context, input *volumes.CreateVolumeInput, opts ...request.Option) (string, error)
 	// PerformVolumeAction performs an action on a volume
 	PerformVolumeAction(input *volumes.VolumeActionInput) error
+	PerformVolumeActionWithContext(ctx context.Context, input *volumes.VolumeActionInput, opts ...request.Option) error
+	// AttachVolume attaches a volume to an instance
+	AttachVolume(id, instanceID string) error
+	AttachVolumeWithContext(ctx context.Context, id, instanceID string, opts ...request.Option) error
+	// DetachVolume detaches a volume from its instances
+	DetachVolume(id string) error
+	DetachVolumeWithContext(ctx context.Context, id string, opts ...request.Option) error
+	// ResizeVolume grows a volume
+	ResizeVolume(id string, size int64) error
+	ResizeVolumeWithContext(ctx context.Context, id string, size int64, opts ...request.Option) error
+	// RenameVolume changes the name of a volume
+	RenameVolume(id, name string) error
+	RenameVolumeWithContext(ctx context.Context, id, name string, opts ...request.Option) error
+	// CloneVolume clones a volume
+	CloneVolume(input *volumes.CloneVolumeInput) (string, error)
+	CloneVolumeWithContext(ctx context.Context, input *volumes.CloneVolumeInput, opts ...request.Option) (string, error)
+	// RestoreVolume restores a volume from the trash
+	RestoreVolume(id string) error
+	RestoreVolumeWithContext(ctx context.Context, id string, opts ...request.Option) error
 	// ListTrashVolumes lists all volumes in trash
 	ListTrashVolumes() ([]*volumes.VolumeResponse, error)
+	ListTrashVolumesWithContext(ctx context.Context, opts ...request.Option) ([]*volumes.VolumeResponse, error)
+	// PurgeVolume permanently deletes a volume in the trash
+	PurgeVolume(id string) error
+	PurgeVolumeWithContext(ctx context.Context, id string, opts ...request.Option) error
+	// PurgeTrash permanently deletes every volume in the trash
+	PurgeTrash() ([]string, error)
+	PurgeTrashWithContext(ctx context.Context, opts ...request.Option) ([]string, error)
 	// DeleteVolume deletes a volume by ID
 	DeleteVolume(id string, isPermanent bool) error
+	DeleteVolumeWithContext(ctx context.Context, id string, isPermanent bool, opts ...request.Option) error
+	// WaitUntilVolumeAttached waits until a volume is attached
+	WaitUntilVolumeAttached(id string) error
+	WaitUntilVolumeAttachedWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error
+	// WaitUntilVolumeDetached waits until a volume is detached
+	WaitUntilVolumeDetached(id string) error
+	WaitUntilVolumeDetachedWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error
+	// WaitUntilVolumeAvailable waits until a new volume is available
+	WaitUntilVolumeAvailable(id string) error
+	WaitUntilVolumeAvailableWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error
+	// WaitUntilVolumeDeleted waits until a volume is deleted
+	WaitUntilVolumeDeleted(id string) error
+	WaitUntilVolumeDeletedWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error
 }
 
 var _ VolumesAPI = (*volumes.Volumes)(nil)
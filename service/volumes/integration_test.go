@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/credentials"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/session"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumes"
 )
@@ -70,15 +71,25 @@ func TestCreateVolume_Integration(t *testing.T) {
 
 	t.Logf("Created volume with ID: %s", volume)
 
-	// cleanup
-
-	defer func() {
+	t.Cleanup(func() {
 		t.Log("Cleaning up test volume...")
-		err := svc.DeleteVolume(volume, true)
-		if err != nil {
+		if err := svc.DeleteVolume(volume, true); err != nil {
 			t.Errorf("failed to delete volume %s: %v", volume, err)
 		} else {
 			t.Log("Successfully cleaned up test volume")
 		}
-	}()
+	})
+
+	if err := svc.WaitUntilVolumeAvailable(volume); err != nil {
+		t.Fatalf("volume %s did not become available: %v", volume, err)
+	}
+
+	if err := svc.RenameVolume(volume, "my-renamed-volume"); err != nil {
+		t.Errorf("failed to rename volume: %v", err)
+	}
+
+	err = svc.ResizeVolume(volume, 10)
+	if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != volumes.ErrCodeVolumeShrink {
+		t.Errorf("expected %s error when shrinking, got %v", volumes.ErrCodeVolumeShrink, err)
+	}
 }
@@ -0,0 +1,265 @@
+package volumes
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/startscripts"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumetypes"
+)
+
+var (
+	// mountDirectoryPattern matches the mount directories accepted in a mount
+	// plan, which are embedded unquoted in shell scripts.
+	mountDirectoryPattern = regexp.MustCompile(`^/[A-Za-z0-9._/-]+$`)
+
+	// fstabFieldPattern matches the fstab fields accepted in a mount plan,
+	// which are embedded in single-quoted shell strings.
+	fstabFieldPattern = regexp.MustCompile(`^[A-Za-z0-9._/:=,@+-]+$`)
+
+	// quotedPattern matches the single- or double-quoted arguments of a
+	// command.
+	quotedPattern = regexp.MustCompile(`'([^']*)'|"([^"]*)"`)
+)
+
+// shellMetacharacters are the characters rejected in the commands returned by
+// the API, which could chain or substitute commands.
+const shellMetacharacters = "\r\n;&|$`<>(){}[]*?!~#'\"\\"
+
+// FstabEntry is a line of /etc/fstab mounting a volume on boot.
+type FstabEntry struct {
+	Device    string
+	Directory string
+	Type      string
+	Options   string
+}
+
+// String returns the entry as an /etc/fstab line.
+func (e FstabEntry) String() string {
+	return fmt.Sprintf("%s %s %s %s 0 0", e.Device, e.Directory, e.Type, e.Options)
+}
+
+// MountPlan describes how to mount a shared filesystem volume on an instance.
+// It is built from the commands returned by the API with NewMountPlan.
+type MountPlan struct {
+	VolumeID string
+	Name     string
+
+	// Directory is the directory the volume is mounted on
+	Directory string
+
+	// CreateDirectoryCommand creates Directory. It is generated from
+	// Directory, never copied from the API.
+	CreateDirectoryCommand string
+
+	// MountCommand mounts the volume on Directory. It is generated from the
+	// validated device, type and options of the mount command returned by
+	// the API.
+	MountCommand string
+
+	// Fstab is the /etc/fstab entry mounting the volume on boot, parsed from
+	// the fstab command returned by the API or derived from MountCommand
+	Fstab FstabEntry
+}
+
+// NewMountPlan returns the mount plan of a shared filesystem volume. The
+// volume types, as listed by the volumetypes client, tell whether the volume
+// is a shared filesystem. An error with the ErrCodeInvalidParameter code
+// batching every problem found is returned if the volume is not a shared
+// filesystem or has no usable mount commands. The commands returned by the
+// API are only parsed: the commands of the plan are generated from the
+// validated fields, and API commands containing shell metacharacters are
+// rejected.
+func NewMountPlan(v *VolumeResponse, types []*volumetypes.VolumeTypeResponse) (*MountPlan, error) {
+	if v == nil {
+		return nil, dcerr.New(request.ErrCodeInvalidParameter, "a volume is required", nil)
+	}
+
+	createDirectoryCommand := strings.TrimSpace(v.CreateDirectoryCommand)
+	mountCommand := strings.TrimSpace(v.MountCommand)
+	plan := &MountPlan{
+		VolumeID:  v.ID,
+		Name:      v.Name,
+		Directory: mountDirectory(createDirectoryCommand),
+	}
+	if plan.Directory == "" {
+		plan.Directory = mountDirectory(mountCommand)
+	}
+
+	var errs []error
+	if !isSharedFS(v.Type, types) {
+		errs = append(errs, invalidMountParam("volume %s of type %q is not a shared filesystem", v.ID, v.Type))
+	}
+	if mountCommand == "" {
+		errs = append(errs, invalidMountParam("volume %s has no mount command", v.ID))
+	}
+	if createDirectoryCommand == "" {
+		errs = append(errs, invalidMountParam("volume %s has no create directory command", v.ID))
+	}
+	if !mountDirectoryPattern.MatchString(plan.Directory) {
+		errs = append(errs, invalidMountParam("volume %s has an invalid mount directory %q", v.ID, plan.Directory))
+	}
+	for _, cmd := range []string{createDirectoryCommand, mountCommand} {
+		if strings.ContainsAny(cmd, shellMetacharacters) {
+			errs = append(errs, invalidMountParam("volume %s has an unsafe command %q", v.ID, cmd))
+		}
+	}
+
+	mount, mountOK := parseMountCommand(mountCommand, plan.Directory)
+	mountOK = mountOK && validFstabEntry(mount)
+	if mountCommand != "" && !mountOK {
+		errs = append(errs, invalidMountParam("volume %s has an unusable mount command %q", v.ID, mountCommand))
+	}
+
+	fstab, ok := parseFstabCommand(v.FilesystemToFstabCommand, plan.Directory)
+	if !ok {
+		fstab, ok = mount, mountOK
+	}
+	if mountCommand != "" && (!ok || !validFstabEntry(fstab)) {
+		errs = append(errs, invalidMountParam("volume %s has no usable fstab entry", v.ID))
+	}
+	plan.Fstab = fstab
+
+	if len(errs) > 0 {
+		return nil, dcerr.NewBatchError(request.ErrCodeInvalidParameter,
+			fmt.Sprintf("%d validation error(s) found.", len(errs)), errs)
+	}
+
+	plan.CreateDirectoryCommand = "mkdir -p " + plan.Directory
+	plan.MountCommand = fmt.Sprintf("mount -t %s -o %s %s %s", mount.Type, mount.Options, mount.Device, mount.Directory)
+	return plan, nil
+}
+
+// Script returns a shell script fragment mounting the volume and adding it to
+// /etc/fstab. It can be run more than once: the fstab entry is only added if
+// missing, and the volume is only mounted if Directory is not already a mount
+// point.
+func (p *MountPlan) Script() string {
+	var b strings.Builder
+	// Names are free text, keep them on the comment line
+	name := strings.Join(strings.Fields(p.Name), " ")
+	fstab := p.Fstab.String()
+	fmt.Fprintf(&b, "# Mount shared filesystem volume %s (%s)\n", name, p.VolumeID)
+	fmt.Fprintf(&b, "%s\n", p.CreateDirectoryCommand)
+	fmt.Fprintf(&b, "grep -qxF '%s' /etc/fstab || echo '%s' >> /etc/fstab\n", fstab, fstab)
+	fmt.Fprintf(&b, "mountpoint -q %s || %s\n", p.Directory, p.MountCommand)
+	return b.String()
+}
+
+// MountScript returns a complete startup script mounting the volumes of the
+// given plans, in order.
+func MountScript(plans ...*MountPlan) string {
+	var b strings.Builder
+	b.WriteString("#!/bin/bash\nset -e\n")
+	for _, p := range plans {
+		if p == nil {
+			continue
+		}
+		b.WriteString("\n")
+		b.WriteString(p.Script())
+	}
+	return b.String()
+}
+
+// NewMountStartScriptInput returns the input registering MountScript as a
+// startup script with the startscripts client. The ID of the created script
+// can then be set as the StartupScriptID of an instance.
+func NewMountStartScriptInput(name string, plans ...*MountPlan) *startscripts.CreateStartScriptInput {
+	return &startscripts.CreateStartScriptInput{
+		Name:   name,
+		Script: MountScript(plans...),
+	}
+}
+
+// isSharedFS returns true if the volume type is listed as a shared
+// filesystem.
+func isSharedFS(volumeType VolumeType, types []*volumetypes.VolumeTypeResponse) bool {
+	for _, t := range types {
+		if t != nil && t.Type == string(volumeType) {
+			return t.IsSharedFS
+		}
+	}
+	return false
+}
+
+// mountDirectory returns the last absolute path argument of a command.
+func mountDirectory(cmd string) string {
+	fields := strings.Fields(cmd)
+	for i := len(fields) - 1; i >= 0; i-- {
+		if strings.HasPrefix(fields[i], "/") {
+			return fields[i]
+		}
+	}
+	return ""
+}
+
+// parseFstabCommand returns the fstab entry written by an fstab command, the
+// first quoted argument holding an entry for directory.
+func parseFstabCommand(cmd, directory string) (FstabEntry, bool) {
+	for _, m := range quotedPattern.FindAllStringSubmatch(cmd, -1) {
+		fields := strings.Fields(m[1] + m[2])
+		if len(fields) < 4 || len(fields) > 6 || fields[1] != directory {
+			continue
+		}
+		return FstabEntry{Device: fields[0], Directory: fields[1], Type: fields[2], Options: fields[3]}, true
+	}
+	return FstabEntry{}, false
+}
+
+// parseMountCommand returns the fstab entry equivalent to a mount command
+// mounting a device on directory, e.g. "mount -t nfs -o nconnect=16
+// server:/share /mnt/share".
+func parseMountCommand(cmd, directory string) (FstabEntry, bool) {
+	fields := strings.Fields(cmd)
+	if len(fields) > 0 && fields[0] == "sudo" {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 || fields[0] != "mount" {
+		return FstabEntry{}, false
+	}
+
+	entry := FstabEntry{Type: "auto", Options: "defaults"}
+	var args []string
+	for i := 1; i < len(fields); i++ {
+		switch fields[i] {
+		case "-t", "-o":
+			if i+1 == len(fields) {
+				return FstabEntry{}, false
+			}
+			if fields[i] == "-t" {
+				entry.Type = fields[i+1]
+			} else {
+				entry.Options = fields[i+1]
+			}
+			i++
+		default:
+			if strings.HasPrefix(fields[i], "-") {
+				return FstabEntry{}, false
+			}
+			args = append(args, fields[i])
+		}
+	}
+	if len(args) != 2 || args[1] != directory {
+		return FstabEntry{}, false
+	}
+	entry.Device, entry.Directory = args[0], args[1]
+	return entry, true
+}
+
+// validFstabEntry returns true if every field of the entry is safe to embed
+// in a script.
+func validFstabEntry(e FstabEntry) bool {
+	for _, field := range []string{e.Device, e.Directory, e.Type, e.Options} {
+		if !fstabFieldPattern.MatchString(field) {
+			return false
+		}
+	}
+	return true
+}
+
+func invalidMountParam(format string, args ...interface{}) error {
+	return dcerr.New(request.ErrCodeInvalidParameter, fmt.Sprintf(format, args...), nil)
+}
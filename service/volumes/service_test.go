@@ -0,0 +1,32 @@
+package volumes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/credentials"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/session"
+)
+
+// newTestClient returns a Volumes client sending its requests to handler.
+// Token requests are answered by the test server.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Volumes {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/oauth2/token" {
+			_ = json.NewEncoder(w).Encode(credentials.TokenResponse{AccessToken: "token", ExpiresIn: 3600})
+			return
+		}
+		handler(w, r)
+	}))
+	t.Cleanup(server.Close)
+
+	return New(session.New(
+		session.WithCredentials("client-id", "client-secret"),
+		session.WithBaseURL(server.URL),
+		session.WithNoRetries(),
+	))
+}
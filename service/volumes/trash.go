@@ -0,0 +1,123 @@
+package volumes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+// ErrCodePurgeTrashFailed is returned by PurgeTrash when some volumes could
+// not be purged.
+const ErrCodePurgeTrashFailed = "PurgeTrashFailed"
+
+// TrashRetention is how long a deleted volume stays in the trash before it
+// is purged automatically.
+var TrashRetention = 96 * time.Hour
+
+// DeletedTime returns when the volume was moved to the trash. False is
+// returned if the volume was not deleted or DeletedAt cannot be parsed.
+func (v *VolumeResponse) DeletedTime() (time.Time, bool) {
+	if v == nil || v.DeletedAt == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339Nano, v.DeletedAt)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// PurgeTime returns when the volume will be purged from the trash, based on
+// DeletedAt and TrashRetention.
+func (v *VolumeResponse) PurgeTime() (time.Time, bool) {
+	deleted, ok := v.DeletedTime()
+	if !ok {
+		return time.Time{}, false
+	}
+	return deleted.Add(TrashRetention), true
+}
+
+// TrashTimeRemaining returns how long the volume stays in the trash after
+// now before it is purged, zero if it is already due.
+func (v *VolumeResponse) TrashTimeRemaining(now time.Time) (time.Duration, bool) {
+	purge, ok := v.PurgeTime()
+	if !ok {
+		return 0, false
+	}
+	if remaining := purge.Sub(now); remaining > 0 {
+		return remaining, true
+	}
+	return 0, true
+}
+
+// PurgeVolume permanently deletes a volume in the trash. An error with the
+// ErrCodeVolumeNotInTrash code is returned if the volume is not in the trash,
+// so that volumes in use are never deleted by mistake.
+func (c *Volumes) PurgeVolume(id string) error {
+	return c.PurgeVolumeWithContext(context.Background(), id)
+}
+
+// PurgeVolumeWithContext is the same as PurgeVolume with the addition of the
+// ability to pass a context and additional request options. The options only
+// apply to the delete request, not to the request listing the trash.
+func (c *Volumes) PurgeVolumeWithContext(ctx context.Context, id string, opts ...request.Option) error {
+	if err := requireParam(id, "volume ID"); err != nil {
+		return err
+	}
+
+	trash, err := c.ListTrashVolumesWithContext(ctx)
+	if err != nil {
+		return err
+	}
+	if findVolume(trash, id) == nil {
+		return dcerr.New(ErrCodeVolumeNotInTrash,
+			fmt.Sprintf("volume %s is not in the trash", id), nil)
+	}
+
+	return c.DeleteVolumeWithContext(ctx, id, true, opts...)
+}
+
+// PurgeTrash permanently deletes every volume in the trash and returns the
+// IDs of the purged volumes. Volumes failing to be purged do not stop the
+// others; their errors are batched in an error with the
+// ErrCodePurgeTrashFailed code.
+func (c *Volumes) PurgeTrash() ([]string, error) {
+	return c.PurgeTrashWithContext(context.Background())
+}
+
+// PurgeTrashWithContext is the same as PurgeTrash with the addition of the
+// ability to pass a context and additional request options. The options only
+// apply to the delete requests, each volume getting its own idempotency key
+// derived from the one given with request.WithIdempotencyKey.
+func (c *Volumes) PurgeTrashWithContext(ctx context.Context, opts ...request.Option) ([]string, error) {
+	trash, err := c.ListTrashVolumesWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var purged []string
+	var errs []error
+	for _, v := range trash {
+		if v == nil {
+			continue
+		}
+		if err := ctx.Err(); err != nil {
+			return purged, dcerr.New(request.CanceledErrorCode, "purging trash canceled", err)
+		}
+		idOpts := append(append([]request.Option{}, opts...), request.WithDerivedIdempotencyKey(v.ID))
+		if err := c.DeleteVolumeWithContext(ctx, v.ID, true, idOpts...); err != nil {
+			errs = append(errs, fmt.Errorf("volume %s: %w", v.ID, err))
+			continue
+		}
+		purged = append(purged, v.ID)
+	}
+
+	if len(errs) > 0 {
+		return purged, dcerr.NewBatchError(ErrCodePurgeTrashFailed,
+			fmt.Sprintf("failed to purge %d of %d volumes", len(errs), len(errs)+len(purged)), errs)
+	}
+	return purged, nil
+}
@@ -0,0 +1,214 @@
+package volumes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+func TestDeleteVolume(t *testing.T) {
+	for _, isPermanent := range []bool{false, true} {
+		var body map[string]interface{}
+		c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodDelete || r.URL.Path != "/volumes/vol-1" {
+				t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("failed to decode body: %v", err)
+			}
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+		if err := c.DeleteVolume("vol-1", isPermanent); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		expected := map[string]interface{}{"is_permanent": isPermanent}
+		if !reflect.DeepEqual(body, expected) {
+			t.Errorf("expected body %v, got %v", expected, body)
+		}
+	}
+}
+
+// newTrashTestClient returns a Volumes client whose trash holds the given
+// volumes. The DELETE requests are recorded with their idempotency key and
+// fail for the IDs in failing.
+func newTrashTestClient(t *testing.T, trash []string, failing ...string) (*Volumes, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var requests []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+r.Header.Get(request.IdempotencyKeyHeader))
+		mu.Unlock()
+
+		if r.Method == http.MethodGet && r.URL.Path == "/volumes/trash" {
+			volumes := make([]*VolumeResponse, 0, len(trash))
+			for _, id := range trash {
+				volumes = append(volumes, &VolumeResponse{ID: id})
+			}
+			_ = json.NewEncoder(w).Encode(volumes)
+			return
+		}
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		var body DeleteVolumeInput
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || !body.IsPermanent {
+			t.Errorf("expected a permanent delete, got %+v (%v)", body, err)
+		}
+		for _, id := range failing {
+			if r.URL.Path == "/volumes/"+id {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = w.Write([]byte(`{"code":"internal_error","message":"boom"}`))
+				return
+			}
+		}
+		w.WriteHeader(http.StatusAccepted)
+	})
+
+	return c, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return requests
+	}
+}
+
+func TestPurgeVolume(t *testing.T) {
+	c, requests := newTrashTestClient(t, []string{"vol-1"})
+
+	err := c.PurgeVolumeWithContext(context.Background(), "vol-1", request.WithIdempotencyKey("key-1"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []string{"GET /volumes/trash ", "DELETE /volumes/vol-1 key-1"}
+	if !reflect.DeepEqual(requests(), expected) {
+		t.Errorf("expected requests %q, got %q", expected, requests())
+	}
+}
+
+func TestPurgeVolume_NotInTrash(t *testing.T) {
+	c, requests := newTrashTestClient(t, []string{"vol-1"})
+
+	err := c.PurgeVolume("vol-2")
+	if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != ErrCodeVolumeNotInTrash {
+		t.Fatalf("expected %s error, got %v", ErrCodeVolumeNotInTrash, err)
+	}
+
+	expected := []string{"GET /volumes/trash "}
+	if !reflect.DeepEqual(requests(), expected) {
+		t.Errorf("expected requests %q, got %q", expected, requests())
+	}
+}
+
+func TestPurgeTrash(t *testing.T) {
+	c, requests := newTrashTestClient(t, []string{"vol-1", "vol-2", "vol-3"}, "vol-2")
+
+	purged, err := c.PurgeTrashWithContext(context.Background(), request.WithIdempotencyKey("key-1"))
+
+	if expected := []string{"vol-1", "vol-3"}; !reflect.DeepEqual(purged, expected) {
+		t.Errorf("expected purged volumes %v, got %v", expected, purged)
+	}
+	batch, ok := err.(dcerr.BatchedErrors)
+	if !ok || batch.Code() != ErrCodePurgeTrashFailed {
+		t.Fatalf("expected %s error, got %v", ErrCodePurgeTrashFailed, err)
+	}
+	if len(batch.OrigErrs()) != 1 {
+		t.Errorf("expected 1 batched error, got %v", batch.OrigErrs())
+	}
+
+	expected := []string{
+		"GET /volumes/trash ",
+		"DELETE /volumes/vol-1 key-1-vol-1",
+		"DELETE /volumes/vol-2 key-1-vol-2",
+		"DELETE /volumes/vol-3 key-1-vol-3",
+	}
+	if !reflect.DeepEqual(requests(), expected) {
+		t.Errorf("expected requests %q, got %q", expected, requests())
+	}
+}
+
+func TestPurgeTrash_Empty(t *testing.T) {
+	c, _ := newTrashTestClient(t, nil)
+
+	purged, err := c.PurgeTrash()
+	if err != nil || len(purged) != 0 {
+		t.Errorf("expected nothing purged, got %v (%v)", purged, err)
+	}
+}
+
+func TestTrashTimes(t *testing.T) {
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+	deleted := now.Add(-24 * time.Hour)
+
+	tests := []struct {
+		name      string
+		deletedAt string
+		deleted   time.Time
+		purge     time.Time
+		remaining time.Duration
+		ok        bool
+	}{
+		{
+			name:      "in trash",
+			deletedAt: deleted.Format(time.RFC3339Nano),
+			deleted:   deleted,
+			purge:     deleted.Add(TrashRetention),
+			remaining: TrashRetention - 24*time.Hour,
+			ok:        true,
+		},
+		{
+			name:      "fractional seconds and offset",
+			deletedAt: "2026-03-09T14:00:00.5+02:00",
+			deleted:   deleted.Add(500 * time.Millisecond),
+			purge:     deleted.Add(TrashRetention + 500*time.Millisecond),
+			remaining: TrashRetention - 24*time.Hour + 500*time.Millisecond,
+			ok:        true,
+		},
+		{
+			name:      "expired",
+			deletedAt: now.Add(-TrashRetention - time.Hour).Format(time.RFC3339),
+			deleted:   now.Add(-TrashRetention - time.Hour),
+			purge:     now.Add(-time.Hour),
+			ok:        true,
+		},
+		{
+			name:      "due now",
+			deletedAt: now.Add(-TrashRetention).Format(time.RFC3339),
+			deleted:   now.Add(-TrashRetention),
+			purge:     now,
+			ok:        true,
+		},
+		{name: "not deleted"},
+		{name: "invalid", deletedAt: "yesterday"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := &VolumeResponse{ID: "vol-1", DeletedAt: tt.deletedAt}
+
+			deleted, ok := v.DeletedTime()
+			if ok != tt.ok || !deleted.Equal(tt.deleted) {
+				t.Errorf("expected deleted time %v (%v), got %v (%v)", tt.deleted, tt.ok, deleted, ok)
+			}
+			purge, ok := v.PurgeTime()
+			if ok != tt.ok || !purge.Equal(tt.purge) {
+				t.Errorf("expected purge time %v (%v), got %v (%v)", tt.purge, tt.ok, purge, ok)
+			}
+			remaining, ok := v.TrashTimeRemaining(now)
+			if ok != tt.ok || remaining != tt.remaining {
+				t.Errorf("expected remaining time %v (%v), got %v (%v)", tt.remaining, tt.ok, remaining, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package volumes
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+const (
+	// volumeWaiterDelay is the default delay between volume status checks
+	volumeWaiterDelay = 5 * time.Second
+
+	// volumeWaiterMaxAttempts is the default number of volume status checks
+	// made before a waiter gives up
+	volumeWaiterMaxAttempts = 60
+)
+
+// WaitUntilVolumeAttached uses the GetVolume API operation to wait until the
+// volume is attached. An error is returned if the volume is deleted or is not
+// attached within the maximum number of attempts.
+func (c *Volumes) WaitUntilVolumeAttached(id string) error {
+	return c.WaitUntilVolumeAttachedWithContext(context.Background(), id)
+}
+
+// WaitUntilVolumeAttachedWithContext is an extended version of
+// WaitUntilVolumeAttached with support for passing a context and options to
+// configure the Waiter and the underlying request options.
+func (c *Volumes) WaitUntilVolumeAttachedWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error {
+	return c.waitForVolumeStatus(ctx, "WaitUntilVolumeAttached", id, []request.WaiterAcceptor{
+		volumeStatusAcceptor(request.SuccessWaiterState, VolumeStatusAttached),
+		volumeStatusAcceptor(request.FailureWaiterState, VolumeStatusDeleted),
+		volumeNotFoundAcceptor(request.FailureWaiterState),
+	}, opts...)
+}
+
+// WaitUntilVolumeDetached uses the GetVolume API operation to wait until the
+// volume is detached. An error is returned if the volume is deleted or is not
+// detached within the maximum number of attempts.
+func (c *Volumes) WaitUntilVolumeDetached(id string) error {
+	return c.WaitUntilVolumeDetachedWithContext(context.Background(), id)
+}
+
+// WaitUntilVolumeDetachedWithContext is an extended version of
+// WaitUntilVolumeDetached with support for passing a context and options to
+// configure the Waiter and the underlying request options.
+func (c *Volumes) WaitUntilVolumeDetachedWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error {
+	return c.waitForVolumeStatus(ctx, "WaitUntilVolumeDetached", id, []request.WaiterAcceptor{
+		volumeStatusAcceptor(request.SuccessWaiterState, VolumeStatusDetached),
+		volumeStatusAcceptor(request.FailureWaiterState, VolumeStatusDeleted),
+		volumeNotFoundAcceptor(request.FailureWaiterState),
+	}, opts...)
+}
+
+// WaitUntilVolumeAvailable uses the GetVolume API operation to wait until a
+// newly created volume has been provisioned and is either attached or
+// detached. An error is returned if the volume is deleted or does not become
+// available within the maximum number of attempts.
+func (c *Volumes) WaitUntilVolumeAvailable(id string) error {
+	return c.WaitUntilVolumeAvailableWithContext(context.Background(), id)
+}
+
+// WaitUntilVolumeAvailableWithContext is an extended version of
+// WaitUntilVolumeAvailable with support for passing a context and options to
+// configure the Waiter and the underlying request options.
+func (c *Volumes) WaitUntilVolumeAvailableWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error {
+	return c.waitForVolumeStatus(ctx, "WaitUntilVolumeAvailable", id, []request.WaiterAcceptor{
+		volumeStatusAcceptor(request.SuccessWaiterState, VolumeStatusDetached),
+		volumeStatusAcceptor(request.SuccessWaiterState, VolumeStatusAttached),
+		volumeStatusAcceptor(request.FailureWaiterState, VolumeStatusDeleted),
+		volumeNotFoundAcceptor(request.FailureWaiterState),
+	}, opts...)
+}
+
+// WaitUntilVolumeDeleted uses the GetVolume API operation to wait until the
+// volume is deleted or no longer found. An error is returned if the volume is
+// not deleted within the maximum number of attempts.
+func (c *Volumes) WaitUntilVolumeDeleted(id string) error {
+	return c.WaitUntilVolumeDeletedWithContext(context.Background(), id)
+}
+
+// WaitUntilVolumeDeletedWithContext is an extended version of
+// WaitUntilVolumeDeleted with support for passing a context and options to
+// configure the Waiter and the underlying request options.
+func (c *Volumes) WaitUntilVolumeDeletedWithContext(ctx context.Context, id string, opts ...request.WaiterOption) error {
+	return c.waitForVolumeStatus(ctx, "WaitUntilVolumeDeleted", id, []request.WaiterAcceptor{
+		volumeStatusAcceptor(request.SuccessWaiterState, VolumeStatusDeleted),
+		volumeNotFoundAcceptor(request.SuccessWaiterState),
+	}, opts...)
+}
+
+// waitForVolumeStatus polls the volume until one of the acceptors matches its
+// status.
+func (c *Volumes) waitForVolumeStatus(ctx context.Context, name, id string, acceptors []request.WaiterAcceptor, opts ...request.WaiterOption) error {
+	w := request.Waiter{
+		Name:        name,
+		MaxAttempts: volumeWaiterMaxAttempts,
+		Delay:       request.ConstantWaiterDelay(volumeWaiterDelay),
+		Acceptors:   acceptors,
+		NewRequest: func(opts []request.Option) (*request.Request, error) {
+			req, _ := c.getVolumeRequest(id)
+			req.ApplyOptions(opts...)
+			return req, nil
+		},
+	}
+	w.ApplyOptions(opts...)
+
+	return w.WaitWithContext(ctx)
+}
+
+// volumeStatusAcceptor returns an acceptor matching the status of a volume
+// in a GetVolume response.
+func volumeStatusAcceptor(state request.WaiterState, status VolumeStatus) request.WaiterAcceptor {
+	return request.WaiterAcceptor{
+		State:    state,
+		Matcher:  request.ValueWaiterMatch,
+		Expected: status,
+		Argument: func(data interface{}) interface{} {
+			volume, ok := data.(*VolumeResponse)
+			if !ok || volume == nil {
+				return nil
+			}
+			return volume.Status
+		},
+	}
+}
+
+// volumeNotFoundAcceptor returns an acceptor matching a GetVolume request
+// rejected because the volume does not exist.
+func volumeNotFoundAcceptor(state request.WaiterState) request.WaiterAcceptor {
+	return request.WaiterAcceptor{
+		State:    state,
+		Matcher:  request.StatusWaiterMatch,
+		Expected: http.StatusNotFound,
+	}
+}
@@ -0,0 +1,82 @@
+package volumes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/dcerr"
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
+)
+
+func TestVolumeWaiters(t *testing.T) {
+	type waitFunc func(c *Volumes, ctx context.Context, id string, opts ...request.WaiterOption) error
+	attached := (*Volumes).WaitUntilVolumeAttachedWithContext
+	detached := (*Volumes).WaitUntilVolumeDetachedWithContext
+	available := (*Volumes).WaitUntilVolumeAvailableWithContext
+	deleted := (*Volumes).WaitUntilVolumeDeletedWithContext
+
+	// An empty status means the volume is not found
+	tests := []struct {
+		name     string
+		wait     waitFunc
+		statuses []VolumeStatus
+		success  bool
+		attempts int32
+	}{
+		{name: "attached after attaching", wait: attached, statuses: []VolumeStatus{VolumeStatusDetached, VolumeStatusAttaching, VolumeStatusAttached}, success: true, attempts: 3},
+		{name: "attached fails on deleted", wait: attached, statuses: []VolumeStatus{VolumeStatusDeleted}, attempts: 1},
+		{name: "attached fails when not found", wait: attached, statuses: []VolumeStatus{""}, attempts: 1},
+		{name: "attached retries until max attempts", wait: attached, statuses: []VolumeStatus{VolumeStatusAttaching}, attempts: 3},
+		{name: "detached after attached", wait: detached, statuses: []VolumeStatus{VolumeStatusAttached, VolumeStatusDetached}, success: true, attempts: 2},
+		{name: "detached fails on deleted", wait: detached, statuses: []VolumeStatus{VolumeStatusDeleted}, attempts: 1},
+		{name: "detached fails when not found", wait: detached, statuses: []VolumeStatus{""}, attempts: 1},
+		{name: "available when detached", wait: available, statuses: []VolumeStatus{VolumeStatusOrdered, VolumeStatusCreating, VolumeStatusDetached}, success: true, attempts: 3},
+		{name: "available when attached", wait: available, statuses: []VolumeStatus{VolumeStatusOrdered, VolumeStatusAttached}, success: true, attempts: 2},
+		{name: "available fails on deleted", wait: available, statuses: []VolumeStatus{VolumeStatusOrdered, VolumeStatusDeleted}, attempts: 2},
+		{name: "available fails when not found", wait: available, statuses: []VolumeStatus{""}, attempts: 1},
+		{name: "deleted when deleted", wait: deleted, statuses: []VolumeStatus{VolumeStatusDeleting, VolumeStatusDeleted}, success: true, attempts: 2},
+		{name: "deleted when not found", wait: deleted, statuses: []VolumeStatus{VolumeStatusDetached, ""}, success: true, attempts: 2},
+		{name: "deleted retries until max attempts", wait: deleted, statuses: []VolumeStatus{VolumeStatusDeleting}, attempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int32
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodGet || r.URL.Path != "/volumes/vol-1" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+				}
+				n := int(atomic.AddInt32(&calls, 1))
+				if n > len(tt.statuses) {
+					n = len(tt.statuses)
+				}
+				status := tt.statuses[n-1]
+				if status == "" {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"code":"not_found","message":"volume not found"}`))
+					return
+				}
+				_ = json.NewEncoder(w).Encode(&VolumeResponse{ID: "vol-1", Status: status})
+			})
+
+			err := tt.wait(c, context.Background(), "vol-1",
+				request.WithWaiterDelay(request.ConstantWaiterDelay(time.Millisecond)),
+				request.WithWaiterMaxAttempts(3))
+			if tt.success && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.success {
+				if aerr, ok := err.(dcerr.Error); !ok || aerr.Code() != request.WaiterResourceNotReadyErrorCode {
+					t.Fatalf("expected %s error, got %v", request.WaiterResourceNotReadyErrorCode, err)
+				}
+			}
+			if got := atomic.LoadInt32(&calls); got != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, got)
+			}
+		})
+	}
+}
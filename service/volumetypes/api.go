@@ -1,6 +1,8 @@
 package volumetypes
 
 import (
+	"context"
+
 	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 )
 
@@ -24,6 +26,12 @@ type VolumeTypeResponse struct {
 
 // ListVolumeTypes lists all available volume types
 func (c *VolumeTypes) ListVolumeTypes() ([]*VolumeTypeResponse, error) {
+	return c.ListVolumeTypesWithContext(context.Background())
+}
+
+// ListVolumeTypesWithContext is the same as ListVolumeTypes with the addition
+// of the ability to pass a context and additional request options.
+func (c *VolumeTypes) ListVolumeTypesWithContext(ctx context.Context, opts ...request.Option) ([]*VolumeTypeResponse, error) {
 	op := &request.Operation{
 		Name:       "ListVolumeTypes",
 		HTTPMethod: "GET",
@@ -32,6 +40,8 @@ func (c *VolumeTypes) ListVolumeTypes() ([]*VolumeTypeResponse, error) {
 
 	var volumeTypes []*VolumeTypeResponse
 	req := c.newRequest(op, nil, &volumeTypes)
+	req.SetContext(ctx)
+	req.ApplyOptions(opts...)
 
 	return volumeTypes, req.Send()
 }
@@ -1,6 +1,9 @@
 package interfaces
 
 import (
+	"context"
+
+	"github.com/datacrunch-io/datacrunch-sdk-go/pkg/request"
 	"github.com/datacrunch-io/datacrunch-sdk-go/service/volumetypes"
 )
 
@@ -8,6 +11,7 @@ import (
 type VolumeTypesAPI interface {
 	// ListVolumeTypes lists all available volume types
 	ListVolumeTypes() ([]*volumetypes.VolumeTypeResponse, error)
+	ListVolumeTypesWithContext(ctx context.Context, opts ...request.Option) ([]*volumetypes.VolumeTypeResponse, error)
 }
 
 var _ VolumeTypesAPI = (*volumetypes.VolumeTypes)(nil)